@@ -0,0 +1,153 @@
+package asym
+
+import (
+	"context"
+	"math/bits"
+	"runtime"
+	"sync"
+
+	"github.com/meshplus/crypto"
+	"github.com/meshplus/crypto-standard/ed25519"
+)
+
+const (
+	// ed25519 signatures per batch equation
+	batchEd25519Size = 64
+	// other signatures per job of the worker pool
+	batchSingleSize = 16
+)
+
+//Bitmap is the result of BatchVerifier.Verify, bit i is set if the i-th tuple is valid
+type Bitmap []uint64
+
+//Valid return whether the i-th tuple is valid
+func (m Bitmap) Valid(i int) bool {
+	return i >= 0 && i>>6 < len(m) && m[i>>6]&(1<<uint(i&63)) != 0
+}
+
+//Count return the number of valid tuples
+func (m Bitmap) Count() int {
+	n := 0
+	for _, w := range m {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+type batchItem struct {
+	key               crypto.Verifier
+	signature, digest []byte
+}
+
+//BatchVerifier verify (key, signature, digest) tuples of mixed algorithms with a bounded worker pool.
+// The Ed25519 tuples are checked together by the batch equation of ed25519.VerifyBatch,
+// the others one by one by the Verify of their key.
+type BatchVerifier struct {
+	workers int
+	items   []batchItem
+}
+
+//NewBatchVerifier get a BatchVerifier, workers bounds the goroutines of Verify, runtime.NumCPU() if workers <= 0
+func NewBatchVerifier(workers int) *BatchVerifier {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return &BatchVerifier{workers: workers}
+}
+
+//Add append a tuple, key is a *ECDSAPublicKey, *RSAPublicKey, *ed25519.EDDSAPublicKey or any crypto.Verifier.
+// digest is the message for Ed25519.
+func (b *BatchVerifier) Add(key crypto.Verifier, signature, digest []byte) *BatchVerifier {
+	b.items = append(b.items, batchItem{key: key, signature: signature, digest: digest})
+	return b
+}
+
+//Len return the number of tuples
+func (b *BatchVerifier) Len() int {
+	return len(b.items)
+}
+
+//Reset remove all the tuples
+func (b *BatchVerifier) Reset() {
+	b.items = b.items[:0]
+}
+
+//Verify verify all the tuples, an error of Verify of a key makes its tuple invalid.
+// If ctx is done before all the tuples are verified, the error is ctx.Err().
+func (b *BatchVerifier) Verify(ctx context.Context) (Bitmap, error) {
+	var jobs [][]int
+	var ed, single []int
+	for i := range b.items {
+		if _, ok := b.items[i].key.(*ed25519.EDDSAPublicKey); ok {
+			if ed = append(ed, i); len(ed) == batchEd25519Size {
+				jobs, ed = append(jobs, ed), nil
+			}
+		} else if single = append(single, i); len(single) == batchSingleSize {
+			jobs, single = append(jobs, single), nil
+		}
+	}
+	for _, job := range [][]int{ed, single} {
+		if len(job) != 0 {
+			jobs = append(jobs, job)
+		}
+	}
+
+	ch := make(chan []int, len(jobs))
+	for _, job := range jobs {
+		ch <- job
+	}
+	close(ch)
+	workers := b.workers
+	if workers > len(jobs) {
+		workers = len(jobs)
+	}
+	valid := make([]bool, len(b.items))
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for job := range ch {
+				if ctx.Err() != nil {
+					return
+				}
+				b.verifyJob(ctx, job, valid)
+			}
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ret := make(Bitmap, (len(b.items)+63)>>6)
+	for i, v := range valid {
+		if v {
+			ret[i>>6] |= 1 << uint(i&63)
+		}
+	}
+	return ret, nil
+}
+
+func (b *BatchVerifier) verifyJob(ctx context.Context, job []int, valid []bool) {
+	if _, ok := b.items[job[0]].key.(*ed25519.EDDSAPublicKey); ok {
+		keys := make([]*ed25519.EDDSAPublicKey, len(job))
+		messages := make([][]byte, len(job))
+		signatures := make([][]byte, len(job))
+		for j, i := range job {
+			keys[j] = b.items[i].key.(*ed25519.EDDSAPublicKey)
+			messages[j], signatures[j] = b.items[i].digest, b.items[i].signature
+		}
+		for j, v := range ed25519.VerifyBatch(keys, messages, signatures) {
+			valid[job[j]] = v
+		}
+		return
+	}
+	for _, i := range job {
+		if ctx.Err() != nil {
+			return
+		}
+		item := &b.items[i]
+		valid[i], _ = item.key.Verify(nil, item.signature, item.digest)
+	}
+}
@@ -0,0 +1,75 @@
+package asym
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+
+	"github.com/meshplus/crypto"
+	"github.com/meshplus/crypto-standard/ed25519"
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	b := NewBatchVerifier(4)
+	var bad []bool
+	for i := 0; i < 200; i++ {
+		m := []byte("tx " + strconv.Itoa(i))
+		digest := sha256.Sum256(m)
+		var key crypto.Verifier
+		var sig []byte
+		switch i % 4 {
+		case 0, 1:
+			sk, pk := ed25519.GenerateKey(nil)
+			sig, _ = sk.Sign(nil, m, nil)
+			key = pk
+			b.Add(key, sig, m)
+		case 2:
+			priv, _ := GenerateKey(AlgoP256R1)
+			sig, _ = priv.Sign(nil, digest[:], rand.Reader)
+			key = priv.Public().(*ECDSAPublicKey)
+			b.Add(key, sig, digest[:])
+		default:
+			priv, _ := GenerateKey(AlgoP256K1Recover)
+			sig, _ = priv.Sign(nil, digest[:], rand.Reader)
+			address, _ := priv.Public().(*ECDSAPublicKey).Bytes()
+			pub := new(ECDSAPublicKey)
+			_ = pub.FromBytes(address, AlgoP256K1Recover)
+			b.Add(pub, sig, digest[:])
+		}
+		// one bad signature every 7 tuples
+		bad = append(bad, i%7 == 3)
+		if i%7 == 3 {
+			sig[10] ^= 1
+		}
+	}
+	rsaKey, _ := GenerateRSAKey(AlgoRSA2048)
+	rsaKey.SetHashType(hash.SHA2_256)
+	digest := sha256.Sum256(msg)
+	sig, _ := rsaKey.Sign(nil, digest[:], nil)
+	b.Add(rsaKey.Public().(*RSAPublicKey), sig, digest[:])
+	bad = append(bad, false)
+	assert.Equal(t, 201, b.Len())
+
+	result, err := b.Verify(context.Background())
+	assert.Nil(t, err)
+	for i := range bad {
+		assert.Equal(t, !bad[i], result.Valid(i), "tuple %d", i)
+	}
+	assert.Equal(t, 201-29, result.Count())
+	assert.False(t, result.Valid(201))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err = b.Verify(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, result)
+
+	b.Reset()
+	result, err = b.Verify(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, result.Count())
+}
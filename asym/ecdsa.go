@@ -3,6 +3,8 @@ package asym
 import (
 	"errors"
 	"io"
+
+	"github.com/meshplus/crypto-standard/hash"
 )
 
 //ECDSA ECDSA instance is a tool to sign and verify.
@@ -11,29 +13,51 @@ import (
 // All in all, ECDSA is convenient; ECDSAPrivateKey and ECDSAPublicKey are faster.
 type ECDSA struct {
 	Opt int
+	//Nonce is the nonce mode of Sign, see ECDSAPrivateKey.SetNonceMode
+	Nonce NonceMode
+	//Policy is the verify policy of Verify, and Sign emits low-S signatures with VerifyLowS
+	Policy VerifyPolicy
+	//HashType is the hash of the digests of the RSA algorithm types, see RSAPrivateKey.SetHashType
+	HashType hash.HashType
 }
 
-//NewECDSA get a ECDSA instance, input parameter is algorithm type
+//NewECDSA get a ECDSA instance, input parameter is algorithm type.
+// The RSA algorithm types are supported too, k is then a PKCS#1 DER key.
 func NewECDSA(opt int) *ECDSA {
 	return &ECDSA{Opt: opt}
 }
 
 //Sign get signature to digest, k is the private key
 func (sv *ECDSA) Sign(k []byte, digest []byte, reader io.Reader) (signature []byte, err error) {
+	if IsRSA(sv.Opt) {
+		key := new(RSAPrivateKey)
+		if err = key.FromBytes(k, sv.Opt); err != nil {
+			return nil, err
+		}
+		return key.SetHashType(sv.HashType).Sign(nil, digest, reader)
+	}
 	tmp := new(ECDSAPrivateKey)
 	err = tmp.FromBytes(k, sv.Opt)
 	if err != nil {
 		return nil, err
 	}
-	return tmp.Sign(nil, digest, reader)
+	tmp.SetVerifyPolicy(sv.Policy)
+	return tmp.SetNonceMode(sv.Nonce).Sign(nil, digest, reader)
 }
 
 //Verify verify signature ,k is the public key
 func (sv *ECDSA) Verify(k []byte, signature, digest []byte) (valid bool, err error) {
+	if IsRSA(sv.Opt) {
+		key := new(RSAPublicKey)
+		if err = key.FromBytes(k, sv.Opt); err != nil {
+			return false, errors.New("k is not a valide public key")
+		}
+		return key.SetHashType(sv.HashType).Verify(nil, signature, digest)
+	}
 	key := new(ECDSAPublicKey)
 	err = key.FromBytes(k, sv.Opt)
 	if err != nil {
 		return false, errors.New("k is not a valide public key")
 	}
-	return key.Verify(nil, signature, digest)
+	return key.VerifyWithPolicy(signature, digest, sv.Policy)
 }
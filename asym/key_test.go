@@ -454,3 +454,61 @@ func BenchmarkSecp256k1_add(B *testing.B) {
 func TestAsn1(t *testing.T) {
 
 }
+
+func TestCompressedPublicKey(t *testing.T) {
+	// the generators of secp256k1 and P-256
+	g, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	pub := new(ECDSAPublicKey)
+	assert.Nil(t, pub.FromBytes(g, AlgoP256K1))
+	assert.Equal(t, secp256k1.S256().Params().Gx, pub.X)
+	assert.Equal(t, secp256k1.S256().Params().Gy, pub.Y)
+	b, err := pub.Bytes()
+	assert.Nil(t, err)
+	assert.Equal(t, g, b)
+	g, _ = hex.DecodeString("036b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296")
+	assert.Nil(t, pub.FromBytes(g, AlgoP256R1))
+	b, _ = pub.CompressedBytes()
+	assert.Equal(t, g, b)
+
+	h, _ := hash.NewHasher(hash.SHA2_256).Hash(msg)
+	for _, opt := range []int{AlgoP256K1, AlgoP256R1, AlgoP384R1, AlgoP521R1} {
+		priv, err := GenerateKey(opt)
+		assert.Nil(t, err)
+		full, _ := priv.Public().(*ECDSAPublicKey).Bytes()
+		compressed, err := priv.Public().(*ECDSAPublicKey).CompressedBytes()
+		assert.Nil(t, err)
+		assert.Equal(t, (len(full)+1)/2, len(compressed))
+		assert.Equal(t, full[1:len(compressed)], compressed[1:])
+
+		parsed := new(ECDSAPublicKey)
+		assert.Nil(t, parsed.FromBytes(compressed, opt))
+		assert.Equal(t, priv.X, parsed.X)
+		assert.Equal(t, priv.Y, parsed.Y)
+		assert.Equal(t, opt, parsed.AlgorithmType())
+
+		sig, err := priv.Sign(nil, h, rand.Reader)
+		assert.Nil(t, err)
+		valid, err := NewECDSA(opt).Verify(compressed, sig, h)
+		assert.Nil(t, err)
+		assert.True(t, valid)
+
+		// x = 7 is not on secp256k1 nor on the NIST curves
+		bad := make([]byte, len(compressed))
+		bad[0], bad[len(bad)-1] = 0x02, 7
+		assert.NotNil(t, parsed.FromBytes(bad, opt))
+		bad[0] = 0x05
+		assert.NotNil(t, parsed.FromBytes(bad, opt))
+		assert.NotNil(t, parsed.FromBytes(compressed[1:], opt))
+
+		// the uncompressed form needs the prefix 0x04 and a point of the curve
+		bad = append([]byte{}, full...)
+		bad[0] = 0x07
+		assert.NotNil(t, parsed.FromBytes(bad, opt))
+		bad[0] = 0x04
+		bad[len(bad)-1] ^= 1
+		assert.NotNil(t, parsed.FromBytes(bad, opt))
+		bad = append(append([]byte{0x04}, priv.Params().P.FillBytes(make([]byte, len(compressed)-1))...), full[len(compressed):]...)
+		assert.NotNil(t, parsed.FromBytes(bad, opt))
+		assert.Nil(t, parsed.FromBytes(full, opt))
+	}
+}
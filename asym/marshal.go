@@ -0,0 +1,320 @@
+package asym
+
+import (
+	"crypto/elliptic"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+)
+
+// PEM block types
+const (
+	pemPKCS8 = "PRIVATE KEY"
+	pemSEC1  = "EC PRIVATE KEY"
+	pemPKIX  = "PUBLIC KEY"
+)
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidP256K1         = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidP256R1         = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidP384R1         = asn1.ObjectIdentifier{1, 3, 132, 0, 34}
+	oidP521R1         = asn1.ObjectIdentifier{1, 3, 132, 0, 35}
+)
+
+// ecPrivateKey is the ECPrivateKey of SEC1 (RFC 5915)
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// pkcs8 is the PrivateKeyInfo of PKCS#8 (RFC 5208)
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// publicKeyInfo is the SubjectPublicKeyInfo of PKIX (RFC 5280)
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+//CurveOID return the named curve OID of an algorithm type, secp256k1 is 1.3.132.0.10
+func CurveOID(opt int) (asn1.ObjectIdentifier, error) {
+	switch opt {
+	case AlgoP256K1, AlgoP256K1Recover:
+		return oidP256K1, nil
+	case AlgoP256R1, AlgoP256R1Recover:
+		return oidP256R1, nil
+	case AlgoP384R1, AlgoP384R1Recover:
+		return oidP384R1, nil
+	case AlgoP521R1:
+		return oidP521R1, nil
+	}
+	return nil, errors.New(errIllegalInputParameter + strconv.Itoa(opt))
+}
+
+//AlgoFromOID return the algorithm type of a named curve OID, never a recover one
+func AlgoFromOID(oid asn1.ObjectIdentifier) (int, error) {
+	switch {
+	case oid.Equal(oidP256K1):
+		return AlgoP256K1, nil
+	case oid.Equal(oidP256R1):
+		return AlgoP256R1, nil
+	case oid.Equal(oidP384R1):
+		return AlgoP384R1, nil
+	case oid.Equal(oidP521R1):
+		return AlgoP521R1, nil
+	}
+	return AlgorithmNone, errors.New("unknown named curve " + oid.String())
+}
+
+func curveAlgo(c elliptic.Curve) int {
+	switch c {
+	case secp256k1.S256():
+		return AlgoP256K1
+	case elliptic.P256():
+		return AlgoP256R1
+	case elliptic.P384():
+		return AlgoP384R1
+	case elliptic.P521():
+		return AlgoP521R1
+	}
+	return AlgorithmNone
+}
+
+//MarshalSEC1 return the SEC1 ECPrivateKey DER of the key, with the curve OID and the public key
+func (key *ECDSAPrivateKey) MarshalSEC1() ([]byte, error) {
+	oid, err := CurveOID(curveAlgo(key.Curve))
+	if err != nil {
+		return nil, err
+	}
+	return key.marshalSEC1(oid)
+}
+
+func (key *ECDSAPrivateKey) marshalSEC1(oid asn1.ObjectIdentifier) ([]byte, error) {
+	d, err := key.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	pub := get65BytesPub(key.X, key.Y, key.Params().BitSize)
+	return asn1.Marshal(ecPrivateKey{
+		Version:       1,
+		PrivateKey:    d,
+		NamedCurveOID: oid,
+		PublicKey:     asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+	})
+}
+
+//MarshalPKCS8 return the PKCS#8 DER of the key, which wraps the SEC1 ECPrivateKey
+func (key *ECDSAPrivateKey) MarshalPKCS8() ([]byte, error) {
+	oid, err := CurveOID(curveAlgo(key.Curve))
+	if err != nil {
+		return nil, err
+	}
+	params, _ := asn1.Marshal(oid)
+	sec1, err := key.marshalSEC1(nil)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pkcs8{
+		Algo:       pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: params}},
+		PrivateKey: sec1,
+	})
+}
+
+//MarshalPKIX return the PKIX SubjectPublicKeyInfo DER of the key, in the uncompressed form
+func (key *ECDSAPublicKey) MarshalPKIX() ([]byte, error) {
+	oid, err := CurveOID(curveAlgo(key.Curve))
+	if err != nil {
+		return nil, err
+	}
+	if key.X == nil || key.Y == nil {
+		return nil, errors.New("X or Y is nil")
+	}
+	params, _ := asn1.Marshal(oid)
+	pub := get65BytesPub(key.X, key.Y, key.Params().BitSize)
+	return asn1.Marshal(publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: params}},
+		PublicKey: asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+	})
+}
+
+//ParseSEC1PrivateKey parse a SEC1 ECPrivateKey DER, the curve OID must be present
+func ParseSEC1PrivateKey(der []byte) (*ECDSAPrivateKey, error) {
+	return parseSEC1(der, nil)
+}
+
+func parseSEC1(der []byte, oid asn1.ObjectIdentifier) (*ECDSAPrivateKey, error) {
+	var k ecPrivateKey
+	if rest, err := asn1.Unmarshal(der, &k); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("trailing data after the EC private key")
+	}
+	if k.Version != 1 {
+		return nil, errors.New("unknown EC private key version " + strconv.Itoa(k.Version))
+	}
+	if len(k.NamedCurveOID) != 0 {
+		if oid != nil && !oid.Equal(k.NamedCurveOID) {
+			return nil, errors.New("curves of the PKCS#8 algorithm and of the EC private key differ")
+		}
+		oid = k.NamedCurveOID
+	}
+	if oid == nil {
+		return nil, errors.New("missing named curve")
+	}
+	opt, err := AlgoFromOID(oid)
+	if err != nil {
+		return nil, err
+	}
+	c, _ := curveOf(opt)
+	d := new(big.Int).SetBytes(k.PrivateKey)
+	if d.Sign() == 0 || d.Cmp(c.Params().N) >= 0 || len(k.PrivateKey) > (c.Params().N.BitLen()+7)>>3 {
+		return nil, errors.New("invalid EC private key")
+	}
+	key := new(ECDSAPrivateKey)
+	if err = key.FromBytes(k.PrivateKey, opt); err != nil {
+		return nil, err
+	}
+	// the optional public key must be d*G
+	if k.PublicKey.BitLength != 0 {
+		pub := new(ECDSAPublicKey)
+		if err = pub.FromBytes(k.PublicKey.RightAlign(), opt); err != nil || pub.X == nil ||
+			pub.X.Cmp(key.X) != 0 || pub.Y.Cmp(key.Y) != 0 {
+			return nil, errors.New("public key of the EC private key does not match")
+		}
+	}
+	return key, nil
+}
+
+//ParsePKCS8PrivateKey parse a PKCS#8 DER of an EC private key
+func ParsePKCS8PrivateKey(der []byte) (*ECDSAPrivateKey, error) {
+	var k pkcs8
+	if rest, err := asn1.Unmarshal(der, &k); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("trailing data after the PKCS#8 private key")
+	}
+	oid, err := algorithmCurve(k.Algo)
+	if err != nil {
+		return nil, err
+	}
+	return parseSEC1(k.PrivateKey, oid)
+}
+
+//ParsePKIXPublicKey parse a PKIX SubjectPublicKeyInfo DER of an EC public key, uncompressed or compressed
+func ParsePKIXPublicKey(der []byte) (*ECDSAPublicKey, error) {
+	var k publicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &k); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("trailing data after the public key")
+	}
+	oid, err := algorithmCurve(k.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	opt, err := AlgoFromOID(oid)
+	if err != nil {
+		return nil, err
+	}
+	curve, err := curveOf(opt)
+	if err != nil {
+		return nil, err
+	}
+	// an uncompressed or compressed SEC1 point, never the 20 bytes address of FromBytes
+	point, byteLen := k.PublicKey.RightAlign(), (curve.Params().BitSize+7)/8
+	if !(len(point) == 1+2*byteLen && point[0] == 4) &&
+		!(len(point) == 1+byteLen && (point[0] == 2 || point[0] == 3)) {
+		return nil, errors.New("public key is not a SEC1 encoded point")
+	}
+	key := new(ECDSAPublicKey)
+	if err = key.FromBytes(point, opt); err != nil {
+		return nil, err
+	}
+	if key.X == nil || !key.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("public key is not on the curve")
+	}
+	return key, nil
+}
+
+// algorithmCurve return the named curve of an id-ecPublicKey AlgorithmIdentifier
+func algorithmCurve(algo pkix.AlgorithmIdentifier) (asn1.ObjectIdentifier, error) {
+	if !algo.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, errors.New("not an EC key, algorithm " + algo.Algorithm.String())
+	}
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(algo.Parameters.FullBytes, &oid); err != nil {
+		return nil, errors.New("EC parameters must be a named curve")
+	}
+	return oid, nil
+}
+
+//MarshalPKCS8PEM return the PKCS#8 DER of the key in a "PRIVATE KEY" PEM block
+func (key *ECDSAPrivateKey) MarshalPKCS8PEM() ([]byte, error) {
+	der, err := key.MarshalPKCS8()
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemPKCS8, Bytes: der}), nil
+}
+
+//MarshalSEC1PEM return the SEC1 DER of the key in an "EC PRIVATE KEY" PEM block
+func (key *ECDSAPrivateKey) MarshalSEC1PEM() ([]byte, error) {
+	der, err := key.MarshalSEC1()
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemSEC1, Bytes: der}), nil
+}
+
+//MarshalPKIXPEM return the PKIX DER of the key in a "PUBLIC KEY" PEM block
+func (key *ECDSAPublicKey) MarshalPKIXPEM() ([]byte, error) {
+	der, err := key.MarshalPKIX()
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemPKIX, Bytes: der}), nil
+}
+
+//ParsePEMPrivateKey parse the first "PRIVATE KEY" or "EC PRIVATE KEY" PEM block of data,
+// other blocks such as "EC PARAMETERS" are skipped
+func ParsePEMPrivateKey(data []byte) (*ECDSAPrivateKey, error) {
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			return nil, errors.New("no private key PEM block")
+		}
+		switch block.Type {
+		case pemPKCS8:
+			return ParsePKCS8PrivateKey(block.Bytes)
+		case pemSEC1:
+			return ParseSEC1PrivateKey(block.Bytes)
+		case pemEncryptedPKCS8:
+			return nil, errors.New("encrypted private key, use ParseEncryptedPKCS8PEM")
+		}
+	}
+}
+
+//ParsePEMPublicKey parse the first "PUBLIC KEY" PEM block of data
+func ParsePEMPublicKey(data []byte) (*ECDSAPublicKey, error) {
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			return nil, errors.New("no public key PEM block")
+		}
+		if block.Type == pemPKIX {
+			return ParsePKIXPublicKey(block.Bytes)
+		}
+	}
+}
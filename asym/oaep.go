@@ -0,0 +1,78 @@
+package asym
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"io"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+//RSAOAEP RSA-OAEP instance is a tool to encrypt and decrypt with key bytes, like ECDSA for signatures.
+// HashType is the hash of OAEP and MGF1, e.g. hash.SHA2_256 or hash.SHA3_256, Label may be nil.
+// Both sides must agree on the hash and the label.
+type RSAOAEP struct {
+	HashType hash.HashType
+	Label    []byte
+}
+
+//NewRSAOAEP get a RSAOAEP instance
+func NewRSAOAEP(hashType hash.HashType, label []byte) *RSAOAEP {
+	return &RSAOAEP{HashType: hashType, Label: label}
+}
+
+//Encrypt encrypt plaintext, k is a PKCS#1 or PKIX DER public key of any size
+func (o *RSAOAEP) Encrypt(k, plaintext []byte, reader io.Reader) (cipherText []byte, err error) {
+	pub, err := parseRSAPublicKey(k)
+	if err != nil {
+		return nil, err
+	}
+	return encryptOAEP(pub, o.HashType, plaintext, o.Label, reader)
+}
+
+//Decrypt decrypt cipherText, k is a PKCS#1 DER private key of any size
+func (o *RSAOAEP) Decrypt(k, cipherText []byte) (plaintext []byte, err error) {
+	priv, err := x509.ParsePKCS1PrivateKey(k)
+	if err != nil {
+		return nil, err
+	}
+	return decryptOAEP(priv, o.HashType, cipherText, o.Label)
+}
+
+//Encrypt encrypt msg with RSA-OAEP, the hash of OAEP and MGF1 is hashType, label may be nil.
+// msg is limited to the key size minus 2*hashSize+2 bytes, so it is usually a session key.
+func (key *RSAPublicKey) Encrypt(hashType hash.HashType, msg, label []byte, reader io.Reader) (cipherText []byte, err error) {
+	if key.N == nil {
+		return nil, errors.New("key is empty")
+	}
+	return encryptOAEP(&key.PublicKey, hashType, msg, label, reader)
+}
+
+//Decrypt decrypt a RSA-OAEP cipherText, hashType and label must be those of Encrypt
+func (key *RSAPrivateKey) Decrypt(hashType hash.HashType, cipherText, label []byte) (plaintext []byte, err error) {
+	if key.key == nil {
+		return nil, errors.New("RSAPrivateKey.key is nil, please invoke FromBytes()")
+	}
+	return decryptOAEP(key.key, hashType, cipherText, label)
+}
+
+func encryptOAEP(pub *rsa.PublicKey, hashType hash.HashType, msg, label []byte, reader io.Reader) ([]byte, error) {
+	h, err := hash.New(hashType)
+	if err != nil {
+		return nil, err
+	}
+	if reader == nil {
+		reader = rand.Reader
+	}
+	return rsa.EncryptOAEP(h, reader, pub, msg, label)
+}
+
+func decryptOAEP(priv *rsa.PrivateKey, hashType hash.HashType, cipherText, label []byte) ([]byte, error) {
+	h, err := hash.New(hashType)
+	if err != nil {
+		return nil, err
+	}
+	return rsa.DecryptOAEP(h, nil, priv, cipherText, label)
+}
@@ -0,0 +1,405 @@
+package asym
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/bits"
+
+	"github.com/meshplus/crypto"
+	"github.com/meshplus/crypto-standard/ed25519"
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/meshplus/crypto-standard/password"
+)
+
+const pemEncryptedPKCS8 = "ENCRYPTED PRIVATE KEY"
+
+//ErrIncorrectPassword is returned by ParseEncryptedPKCS8 if the password is wrong or the data is corrupted
+var ErrIncorrectPassword = errors.New("incorrect password or corrupted private key")
+
+var (
+	oidPBES2   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidScrypt  = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11591, 4, 11}
+	oidEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+)
+
+// the HMAC PRFs of PBKDF2, hmacWithSHA1 is the default
+var pbkdf2PRF = []struct {
+	oid      asn1.ObjectIdentifier
+	hashType hash.HashType
+}{
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}, hash.SHA1},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 8}, hash.SHA2_224},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}, hash.SHA2_256},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}, hash.SHA2_384},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}, hash.SHA2_512},
+}
+
+// The cost of the KDF of an encrypted key is bounded: 128*N*r of scrypt is at most 1 GiB,
+// r*p is below 2^30 as RFC 7914 requires, and PBKDF2 runs at most 10,000,000 iterations.
+const (
+	maxScryptNR         = 1 << 23
+	maxScryptRP         = 1 << 30
+	maxPBKDF2Iterations = 10000000
+)
+
+//PBES2Cipher is the encryption scheme of PBES2
+type PBES2Cipher int
+
+//PBES2 encryption schemes, the zero value is AES256CBC. OpenSSL does not read the GCM ones.
+const (
+	AES256CBC PBES2Cipher = iota
+	AES128CBC
+	AES192CBC
+	AES128GCM
+	AES256GCM
+)
+
+var pbes2Ciphers = []struct {
+	oid    asn1.ObjectIdentifier
+	keyLen int
+	gcm    bool
+}{
+	AES256CBC: {asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}, 32, false},
+	AES128CBC: {asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}, 16, false},
+	AES192CBC: {asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}, 24, false},
+	AES128GCM: {asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 6}, 16, true},
+	AES256GCM: {asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 46}, 32, true},
+}
+
+//PBES2Params the parameters of MarshalEncryptedPKCS8.
+// KDF is a password.PBKDF2Params or a password.ScryptParams, nil means PBKDF2 with its defaults.
+// The PBKDF2 hash type is one of SHA1 and SHA2, the KeyLen of KDF is ignored, the key length is the one of Cipher.
+type PBES2Params struct {
+	KDF    password.Params
+	Cipher PBES2Cipher
+}
+
+// encryptedPrivateKeyInfo is the EncryptedPrivateKeyInfo of PKCS#8 (RFC 5208)
+type encryptedPrivateKeyInfo struct {
+	Algo          pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// pbes2Params is the PBES2-params of RFC 8018
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// pbkdf2Params is the PBKDF2-params of RFC 8018, the salt is always specified
+type pbkdf2Params struct {
+	Salt       []byte
+	Iterations int
+	KeyLength  int                      `asn1:"optional"`
+	PRF        pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// scryptParams is the scrypt-params of RFC 7914
+type scryptParams struct {
+	Salt      []byte
+	N         int
+	R         int
+	P         int
+	KeyLength int `asn1:"optional"`
+}
+
+// gcmParams is the GCMParameters of RFC 5084
+type gcmParams struct {
+	Nonce  []byte
+	ICVLen int `asn1:"optional,default:12"`
+}
+
+//MarshalEncryptedPKCS8 return the PKCS#8 EncryptedPrivateKeyInfo DER of a *ECDSAPrivateKey or a *ed25519.EDDSAPrivateKey,
+// encrypted with PBES2 (RFC 8018). params nil means PBKDF2-HMAC-SHA2-256 and AES-256-CBC.
+// The CBC outputs are read by openssl pkcs8.
+func MarshalEncryptedPKCS8(key crypto.Signer, pw []byte, params *PBES2Params) ([]byte, error) {
+	if params == nil {
+		params = new(PBES2Params)
+	}
+	if params.Cipher < 0 || int(params.Cipher) >= len(pbes2Ciphers) {
+		return nil, errors.New("unknown PBES2 cipher")
+	}
+	var plain []byte
+	var err error
+	switch k := key.(type) {
+	case *ECDSAPrivateKey:
+		plain, err = k.MarshalPKCS8()
+	case *ed25519.EDDSAPrivateKey:
+		plain, err = marshalEd25519PKCS8(k)
+	default:
+		err = errors.New("unsupported private key type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams := params.KDF
+	if kdfParams == nil {
+		kdfParams = password.PBKDF2Params{}
+	}
+	kdf, salt, err := marshalKDF(kdfParams)
+	if err != nil {
+		return nil, err
+	}
+	c := pbes2Ciphers[params.Cipher]
+	derived, err := password.DeriveKey(pw, salt, c.keyLen, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := aes.NewCipher(derived)
+	var encrypted, iv []byte
+	if c.gcm {
+		nonce := make([]byte, 12)
+		if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+		aead, _ := cipher.NewGCM(block)
+		encrypted = aead.Seal(nil, nonce, plain, nil)
+		iv, err = asn1.Marshal(gcmParams{Nonce: nonce, ICVLen: aead.Overhead()})
+	} else {
+		nonce := make([]byte, aes.BlockSize)
+		if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+		padding := aes.BlockSize - len(plain)%aes.BlockSize
+		encrypted = append(plain, bytes.Repeat([]byte{byte(padding)}, padding)...)
+		cipher.NewCBCEncrypter(block, nonce).CryptBlocks(encrypted, encrypted)
+		iv, err = asn1.Marshal(nonce)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: kdf,
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: c.oid, Parameters: asn1.RawValue{FullBytes: iv}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algo:          pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: scheme}},
+		EncryptedData: encrypted,
+	})
+}
+
+// marshalKDF return the KeyDerivationFunc of PBES2 and a random salt
+func marshalKDF(kdf password.Params) (pkix.AlgorithmIdentifier, []byte, error) {
+	var algo pkix.AlgorithmIdentifier
+	var params interface{}
+	var salt []byte
+	switch p := password.WithDefaults(kdf).(type) {
+	case password.PBKDF2Params:
+		if p.Iterations > maxPBKDF2Iterations {
+			return algo, nil, errors.New("too many PBKDF2 iterations")
+		}
+		salt = make([]byte, p.SaltLen)
+		pbkdf2 := pbkdf2Params{Salt: salt, Iterations: p.Iterations}
+		for _, prf := range pbkdf2PRF {
+			if prf.hashType == p.HashType && p.HashType != hash.SHA1 {
+				pbkdf2.PRF = pkix.AlgorithmIdentifier{Algorithm: prf.oid, Parameters: asn1.NullRawValue}
+			}
+		}
+		if pbkdf2.PRF.Algorithm == nil && p.HashType != hash.SHA1 {
+			return algo, nil, errors.New("PBKDF2 of PBES2 supports only SHA1 and SHA2")
+		}
+		algo.Algorithm, params = oidPBKDF2, pbkdf2
+	case password.ScryptParams:
+		if p.LogN >= 24 || p.R > maxScryptNR>>p.LogN || uint64(p.R)*uint64(p.P) >= maxScryptRP {
+			return algo, nil, errors.New("scrypt cost is too high")
+		}
+		salt = make([]byte, p.SaltLen)
+		algo.Algorithm, params = oidScrypt, scryptParams{Salt: salt, N: 1 << p.LogN, R: p.R, P: p.P}
+	default:
+		return algo, nil, errors.New("KDF of PBES2 must be PBKDF2 or scrypt")
+	}
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return algo, nil, err
+	}
+	der, err := asn1.Marshal(params)
+	if err != nil {
+		return algo, nil, err
+	}
+	algo.Parameters = asn1.RawValue{FullBytes: der}
+	return algo, salt, nil
+}
+
+//ParseEncryptedPKCS8 decrypt a PKCS#8 EncryptedPrivateKeyInfo DER of MarshalEncryptedPKCS8 or openssl pkcs8 -topk8,
+// the key is a *ECDSAPrivateKey or a *ed25519.EDDSAPrivateKey. A wrong password returns ErrIncorrectPassword.
+func ParseEncryptedPKCS8(der, pw []byte) (crypto.Signer, error) {
+	var info encryptedPrivateKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("trailing data after the encrypted private key")
+	}
+	if !info.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, errors.New("unsupported encryption algorithm " + info.Algo.Algorithm.String())
+	}
+	var scheme pbes2Params
+	if _, err := asn1.Unmarshal(info.Algo.Parameters.FullBytes, &scheme); err != nil {
+		return nil, err
+	}
+	c := -1
+	for i := range pbes2Ciphers {
+		if pbes2Ciphers[i].oid.Equal(scheme.EncryptionScheme.Algorithm) {
+			c = i
+		}
+	}
+	if c < 0 {
+		return nil, errors.New("unsupported PBES2 cipher " + scheme.EncryptionScheme.Algorithm.String())
+	}
+	kdf, salt, err := parseKDF(scheme.KeyDerivationFunc, pbes2Ciphers[c].keyLen)
+	if err != nil {
+		return nil, err
+	}
+	derived, err := password.DeriveKey(pw, salt, pbes2Ciphers[c].keyLen, kdf)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := pbes2Decrypt(derived, pbes2Ciphers[c].gcm, scheme.EncryptionScheme.Parameters.FullBytes, info.EncryptedData)
+	if err != nil {
+		return nil, err
+	}
+	return parsePKCS8(plain)
+}
+
+// parseKDF return the KDF parameters and the salt of a KeyDerivationFunc of PBES2
+func parseKDF(algo pkix.AlgorithmIdentifier, keyLen int) (password.Params, []byte, error) {
+	switch {
+	case algo.Algorithm.Equal(oidPBKDF2):
+		var p pbkdf2Params
+		if _, err := asn1.Unmarshal(algo.Parameters.FullBytes, &p); err != nil {
+			return nil, nil, err
+		}
+		if p.Iterations <= 0 || p.Iterations > maxPBKDF2Iterations || p.KeyLength != 0 && p.KeyLength != keyLen {
+			return nil, nil, errors.New("invalid PBKDF2 parameters")
+		}
+		hashType := hash.SHA1
+		if p.PRF.Algorithm != nil {
+			hashType = 0
+			for _, prf := range pbkdf2PRF {
+				if prf.oid.Equal(p.PRF.Algorithm) {
+					hashType = prf.hashType
+				}
+			}
+			if hashType == 0 {
+				return nil, nil, errors.New("unsupported PBKDF2 PRF " + p.PRF.Algorithm.String())
+			}
+		}
+		return password.PBKDF2Params{HashType: hashType, Iterations: p.Iterations}, p.Salt, nil
+	case algo.Algorithm.Equal(oidScrypt):
+		var p scryptParams
+		if _, err := asn1.Unmarshal(algo.Parameters.FullBytes, &p); err != nil {
+			return nil, nil, err
+		}
+		// N is a power of 2
+		if p.N <= 1 || p.N&(p.N-1) != 0 || p.R <= 0 || p.P <= 0 || p.R > maxScryptNR/p.N ||
+			uint64(p.R)*uint64(p.P) >= maxScryptRP || p.KeyLength != 0 && p.KeyLength != keyLen {
+			return nil, nil, errors.New("invalid scrypt parameters")
+		}
+		logN := uint8(bits.TrailingZeros(uint(p.N)))
+		return password.ScryptParams{LogN: logN, R: p.R, P: p.P}, p.Salt, nil
+	}
+	return nil, nil, errors.New("unsupported PBES2 KDF " + algo.Algorithm.String())
+}
+
+func pbes2Decrypt(key []byte, gcm bool, params, encrypted []byte) ([]byte, error) {
+	block, _ := aes.NewCipher(key)
+	if gcm {
+		var p gcmParams
+		p.ICVLen = 12
+		if _, err := asn1.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		if len(p.Nonce) != 12 || p.ICVLen < 12 || p.ICVLen > 16 {
+			return nil, errors.New("invalid GCM parameters")
+		}
+		aead, _ := cipher.NewGCMWithTagSize(block, p.ICVLen)
+		plain, err := aead.Open(nil, p.Nonce, encrypted, nil)
+		if err != nil {
+			return nil, ErrIncorrectPassword
+		}
+		return plain, nil
+	}
+
+	var iv []byte
+	if _, err := asn1.Unmarshal(params, &iv); err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid CBC IV")
+	}
+	if len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+		return nil, ErrIncorrectPassword
+	}
+	plain := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, encrypted)
+	padding := int(plain[len(plain)-1])
+	if padding == 0 || padding > aes.BlockSize ||
+		subtle.ConstantTimeCompare(plain[len(plain)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) != 1 {
+		return nil, ErrIncorrectPassword
+	}
+	return plain[:len(plain)-padding], nil
+}
+
+// parsePKCS8 parse a PrivateKeyInfo of an EC or Ed25519 key
+func parsePKCS8(der []byte) (crypto.Signer, error) {
+	var k pkcs8
+	if _, err := asn1.Unmarshal(der, &k); err != nil {
+		// a wrong password which happens to leave a valid padding
+		return nil, ErrIncorrectPassword
+	}
+	if !k.Algo.Algorithm.Equal(oidEd25519) {
+		return ParsePKCS8PrivateKey(der)
+	}
+	var seed []byte
+	if rest, err := asn1.Unmarshal(k.PrivateKey, &seed); err != nil || len(rest) != 0 || len(seed) != ed25519.SeedSize {
+		return nil, errors.New("invalid Ed25519 private key")
+	}
+	key, _ := ed25519.GenerateKey(bytes.NewReader(seed))
+	return key, nil
+}
+
+// marshalEd25519PKCS8 return the PrivateKeyInfo of RFC 8410, the seed in an OCTET STRING
+func marshalEd25519PKCS8(key *ed25519.EDDSAPrivateKey) ([]byte, error) {
+	seed, err := asn1.Marshal(key[:ed25519.SeedSize])
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pkcs8{
+		Algo:       pkix.AlgorithmIdentifier{Algorithm: oidEd25519},
+		PrivateKey: seed,
+	})
+}
+
+//MarshalEncryptedPKCS8PEM return the DER of MarshalEncryptedPKCS8 in an "ENCRYPTED PRIVATE KEY" PEM block
+func MarshalEncryptedPKCS8PEM(key crypto.Signer, pw []byte, params *PBES2Params) ([]byte, error) {
+	der, err := MarshalEncryptedPKCS8(key, pw, params)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemEncryptedPKCS8, Bytes: der}), nil
+}
+
+//ParseEncryptedPKCS8PEM decrypt the first "ENCRYPTED PRIVATE KEY" PEM block of data
+func ParseEncryptedPKCS8PEM(data, pw []byte) (crypto.Signer, error) {
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			return nil, errors.New("no encrypted private key PEM block")
+		}
+		if block.Type == pemEncryptedPKCS8 {
+			return ParseEncryptedPKCS8(block.Bytes, pw)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package asym
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+)
+
+//VerifyPolicy restrict the signatures accepted by ECDSAPublicKey.Verify, the flags can be combined.
+// The zero policy accepts every signature the curve equation accepts, as before.
+type VerifyPolicy int
+
+//verify policies
+const (
+	//VerifyLowS reject s > n/2, so s and n-s are not both valid (BIP-62, EIP-2)
+	VerifyLowS VerifyPolicy = 1 << iota
+	//VerifyStrictDER reject ASN.1 signatures which are not the canonical DER encoding or have trailing bytes
+	VerifyStrictDER
+	//VerifyRange reject r or s out of [1, n-1] before the curve arithmetic
+	VerifyRange
+	//VerifyStrict all of the above
+	VerifyStrict = VerifyLowS | VerifyStrictDER | VerifyRange
+)
+
+var errMalleableSignature = errors.New("signature is not canonical")
+
+//SetVerifyPolicy set the policy of Verify, the signatures of a ECDSAPrivateKey with VerifyLowS are low-S too
+func (key *ECDSAPublicKey) SetVerifyPolicy(policy VerifyPolicy) *ECDSAPublicKey {
+	key.policy = policy
+	return key
+}
+
+//VerifyWithPolicy verify signature like Verify, with policy instead of the policy of the key
+func (key *ECDSAPublicKey) VerifyWithPolicy(signature, digest []byte, policy VerifyPolicy) (valid bool, err error) {
+	if policy != 0 && key.Curve != nil {
+		if err = checkPolicy(key.Curve, signature, policy); err != nil {
+			return false, err
+		}
+	}
+	return key.verify(signature, digest)
+}
+
+//NormalizeSignature return signature with s replaced by n-s if s > n/2, opt is the algorithm type of the key.
+// signature is an ASN.1 signature or a recoverable r || s || v signature, whose v is fixed too.
+func NormalizeSignature(opt int, signature []byte) ([]byte, error) {
+	c, err := curveOf(opt)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeS(c, signature)
+}
+
+func curveOf(opt int) (elliptic.Curve, error) {
+	switch opt {
+	case AlgoP256K1, AlgoP256K1Recover:
+		return secp256k1.S256(), nil
+	case AlgoP256R1, AlgoP256R1Recover:
+		return elliptic.P256(), nil
+	case AlgoP384R1, AlgoP384R1Recover:
+		return elliptic.P384(), nil
+	case AlgoP521R1:
+		return elliptic.P521(), nil
+	}
+	return nil, errors.New(errIllegalInputParameter + strconv.Itoa(opt))
+}
+
+func normalizeS(c elliptic.Curve, signature []byte) ([]byte, error) {
+	n := c.Params().N
+	half := new(big.Int).Rsh(n, 1)
+	if isRecoverable(c, signature) {
+		byteLen := len(signature) / 2
+		s := new(big.Int).SetBytes(signature[byteLen : 2*byteLen])
+		if s.Cmp(half) <= 0 {
+			return signature, nil
+		}
+		ret := make([]byte, len(signature))
+		copy(ret, signature[:byteLen])
+		s.Sub(n, s).FillBytes(ret[byteLen : 2*byteLen])
+		ret[2*byteLen] = signature[2*byteLen] ^ 1
+		return ret, nil
+	}
+	var sig ECDSASignature
+	if _, err := asn1.Unmarshal(signature, &sig); err != nil {
+		return nil, err
+	}
+	if sig.S.Sign() <= 0 || sig.S.Cmp(n) >= 0 {
+		return nil, errors.New(errInvalidSignature)
+	}
+	if sig.S.Cmp(half) <= 0 {
+		return signature, nil
+	}
+	sig.S.Sub(n, sig.S)
+	return asn1.Marshal(sig)
+}
+
+func checkPolicy(c elliptic.Curve, signature []byte, policy VerifyPolicy) error {
+	var r, s *big.Int
+	if isRecoverable(c, signature) {
+		byteLen := len(signature) / 2
+		r = new(big.Int).SetBytes(signature[:byteLen])
+		s = new(big.Int).SetBytes(signature[byteLen : 2*byteLen])
+	} else {
+		var sig ECDSASignature
+		rest, err := asn1.Unmarshal(signature, &sig)
+		if err != nil {
+			return err
+		}
+		if policy&VerifyStrictDER != 0 {
+			der, _ := asn1.Marshal(sig)
+			if len(rest) != 0 || !bytes.Equal(der, signature) {
+				return errMalleableSignature
+			}
+		}
+		r, s = sig.R, sig.S
+	}
+	n := c.Params().N
+	if policy&VerifyRange != 0 && (r.Sign() <= 0 || r.Cmp(n) >= 0 || s.Sign() <= 0 || s.Cmp(n) >= 0) {
+		return errors.New(errInvalidSignature)
+	}
+	if policy&VerifyLowS != 0 && s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		return errMalleableSignature
+	}
+	return nil
+}
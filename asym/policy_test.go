@@ -0,0 +1,120 @@
+package asym
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyPolicy(t *testing.T) {
+	digest := sha256.Sum256(msg)
+	for _, opt := range []int{AlgoP256K1, AlgoP256R1, AlgoP384R1, AlgoP521R1} {
+		priv, err := GenerateKey(opt)
+		assert.Nil(t, err)
+		pub := priv.Public().(*ECDSAPublicKey)
+		n := pub.Params().N
+		sig, err := priv.Sign(nil, digest[:], rand.Reader)
+		assert.Nil(t, err)
+		var parsed ECDSASignature
+		_, err = asn1.Unmarshal(sig, &parsed)
+		assert.Nil(t, err)
+		low, high := new(big.Int).Set(parsed.S), new(big.Int).Sub(n, parsed.S)
+		if low.Cmp(high) > 0 {
+			low, high = high, low
+		}
+		lowSig, _ := asn1.Marshal(ECDSASignature{R: parsed.R, S: low})
+		highSig, _ := asn1.Marshal(ECDSASignature{R: parsed.R, S: high})
+
+		// both are valid by default
+		for _, s := range [][]byte{lowSig, highSig, append(lowSig, 0)} {
+			valid, err := pub.Verify(nil, s, digest[:])
+			assert.Nil(t, err)
+			assert.True(t, valid)
+		}
+
+		valid, err := pub.VerifyWithPolicy(highSig, digest[:], VerifyLowS)
+		assert.NotNil(t, err)
+		assert.False(t, valid)
+		valid, err = pub.VerifyWithPolicy(append(lowSig, 0), digest[:], VerifyStrictDER)
+		assert.NotNil(t, err)
+		assert.False(t, valid)
+		outOfRange, _ := asn1.Marshal(ECDSASignature{R: parsed.R, S: new(big.Int).Add(low, n)})
+		_, err = pub.VerifyWithPolicy(outOfRange, digest[:], VerifyRange)
+		assert.NotNil(t, err)
+
+		pub.SetVerifyPolicy(VerifyStrict)
+		valid, err = pub.Verify(nil, lowSig, digest[:])
+		assert.Nil(t, err)
+		assert.True(t, valid)
+		valid, _ = pub.Verify(nil, highSig, digest[:])
+		assert.False(t, valid)
+		valid, _ = pub.VerifyWithPolicy(highSig, digest[:], 0)
+		assert.True(t, valid)
+
+		normalized, err := NormalizeSignature(opt, highSig)
+		assert.Nil(t, err)
+		assert.Equal(t, lowSig, normalized)
+		normalized, _ = NormalizeSignature(opt, lowSig)
+		assert.Equal(t, lowSig, normalized)
+
+		// a key with VerifyLowS signs low-S
+		priv.SetVerifyPolicy(VerifyLowS)
+		half := new(big.Int).Rsh(n, 1)
+		for i := 0; i < 16; i++ {
+			sig, err = priv.Sign(nil, digest[:], rand.Reader)
+			assert.Nil(t, err)
+			_, _ = asn1.Unmarshal(sig, &parsed)
+			assert.True(t, parsed.S.Cmp(half) <= 0)
+		}
+
+		// ECDSA instance
+		k, _ := priv.Bytes()
+		sv := &ECDSA{Opt: opt, Policy: VerifyStrict}
+		sig, err = sv.Sign(k, digest[:], rand.Reader)
+		assert.Nil(t, err)
+		p, _ := pub.Bytes()
+		valid, err = sv.Verify(p, sig, digest[:])
+		assert.Nil(t, err)
+		assert.True(t, valid)
+		valid, _ = sv.Verify(p, highSig, digest[:])
+		assert.False(t, valid)
+	}
+	_, err := NormalizeSignature(AlgoRSA2048, nil)
+	assert.NotNil(t, err)
+}
+
+func TestVerifyPolicyRecover(t *testing.T) {
+	digest := sha256.Sum256(msg)
+	priv, err := GenerateKey(AlgoP256K1Recover)
+	assert.Nil(t, err)
+	sig, err := priv.Sign(nil, digest[:], rand.Reader)
+	assert.Nil(t, err)
+	// libsecp256k1 signs low-S, the high-S twin has the other recovery id
+	high := make([]byte, 65)
+	copy(high, sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	s.Sub(secp256k1.N, s).FillBytes(high[32:64])
+	high[64] = sig[64] ^ 1
+
+	address, _ := priv.Public().(*ECDSAPublicKey).Bytes()
+	pub := new(ECDSAPublicKey)
+	assert.Nil(t, pub.FromBytes(address, AlgoP256K1Recover))
+	valid, err := pub.Verify(nil, high, digest[:])
+	assert.Nil(t, err)
+	assert.True(t, valid)
+	valid, err = pub.SetVerifyPolicy(VerifyStrict).Verify(nil, high, digest[:])
+	assert.NotNil(t, err)
+	assert.False(t, valid)
+	valid, err = pub.Verify(nil, sig, digest[:])
+	assert.Nil(t, err)
+	assert.True(t, valid)
+
+	normalized, err := NormalizeSignature(AlgoP256K1Recover, high)
+	assert.Nil(t, err)
+	assert.Equal(t, sig, normalized)
+}
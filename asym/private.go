@@ -22,7 +22,8 @@ type ECDSASignature struct {
 // never new(ECDSAPrivateKey), use NewECDSAPrivateKey()
 type ECDSAPrivateKey struct {
 	ECDSAPublicKey
-	D *big.Int
+	D     *big.Int
+	nonce NonceMode
 }
 
 func generateKeyParam(c elliptic.Curve) (X, Y, D *big.Int) {
@@ -44,9 +45,9 @@ func GenerateKey(opt int) (*ECDSAPrivateKey, error) {
 	switch opt {
 	case AlgoP256K1, AlgoP256K1Recover:
 		curve = secp256k1.S256()
-	case AlgoP256R1:
+	case AlgoP256R1, AlgoP256R1Recover:
 		curve = elliptic.P256()
-	case AlgoP384R1:
+	case AlgoP384R1, AlgoP384R1Recover:
 		curve = elliptic.P384()
 	case AlgoP521R1:
 		curve = elliptic.P521()
@@ -59,7 +60,7 @@ func GenerateKey(opt int) (*ECDSAPrivateKey, error) {
 			Curve:   curve,
 			X:       X,
 			Y:       Y,
-			recover: opt == AlgoP256K1Recover,
+			recover: opt == AlgoP256K1Recover || opt == AlgoP256R1Recover || opt == AlgoP384R1Recover,
 		},
 		D: D,
 	}, nil
@@ -88,8 +89,14 @@ func (key *ECDSAPrivateKey) FromBytes(k []byte, opt int) error {
 		fallthrough
 	case AlgoP256K1:
 		key.Curve = secp256k1.S256()
+	case AlgoP256R1Recover:
+		key.recover = true
+		key.Curve = elliptic.P256()
 	case AlgoP256R1:
 		key.Curve = elliptic.P256()
+	case AlgoP384R1Recover:
+		key.recover = true
+		key.Curve = elliptic.P384()
 	case AlgoP384R1:
 		key.Curve = elliptic.P384()
 	case AlgoP521R1:
@@ -134,12 +141,39 @@ func (key *ECDSAPrivateKey) Public() std.PublicKey {
 	return &key.ECDSAPublicKey
 }
 
+//SetNonceMode select the nonce generation of Sign, NonceRandom by default
+func (key *ECDSAPrivateKey) SetNonceMode(mode NonceMode) *ECDSAPrivateKey {
+	key.nonce = mode
+	return key
+}
+
 //Sign get signature of specific digest by ECDSAPrivateKey self,so the first parameter will be ignored
 // signature is 65 bytes: r + s + v
 // if s is odd, v == 01
 // if s is even, v == 00
 // look Ethereum yellow paper
+// AlgoP256R1Recover and AlgoP384R1Recover keys sign r + s + v too, 65 and 97 bytes.
+// The nonce is drawn from reader, or derived by RFC 6979 according to SetNonceMode.
+// s is normalized to the low half if the policy of the key has VerifyLowS.
 func (key *ECDSAPrivateKey) Sign(k, digest []byte, reader io.Reader) (signature []byte, err error) {
+	signature, err = key.sign(digest, reader)
+	if err != nil || key.policy&VerifyLowS == 0 {
+		return signature, err
+	}
+	return normalizeS(key.Curve, signature)
+}
+
+func (key *ECDSAPrivateKey) sign(digest []byte, reader io.Reader) (signature []byte, err error) {
+	var extra []byte
+	if key.nonce == NonceHedged {
+		if reader == nil {
+			reader = rand.Reader
+		}
+		extra = make([]byte, 32)
+		if _, err = io.ReadFull(reader, extra); err != nil {
+			return nil, err
+		}
+	}
 	//secp256k1使用的签名算法是C实现的
 	if key.Curve == secp256k1.S256() && key.recover {
 		b := key.D.Bytes()
@@ -148,20 +182,32 @@ func (key *ECDSAPrivateKey) Sign(k, digest []byte, reader io.Reader) (signature
 			copy(tmp[32-len(b):], b)
 			b = tmp
 		}
+		if key.nonce != NonceRandom {
+			return secp256k1.SignRFC6979(digest, b, extra)
+		}
 		return secp256k1.Sign(digest, b, reader)
 	}
 
-	r, s, err := ecdsa.Sign(reader, &ecdsa.PrivateKey{
-		PublicKey: ecdsa.PublicKey{
-			Curve: key.Curve,
-			X:     key.X,
-			Y:     key.Y,
-		},
-		D: key.D,
-	}, digest)
+	var r, s *big.Int
+	if key.nonce != NonceRandom {
+		r, s, err = signRFC6979(key.Curve, key.D, digest, extra)
+	} else {
+		r, s, err = ecdsa.Sign(reader, &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: key.Curve,
+				X:     key.X,
+				Y:     key.Y,
+			},
+			D: key.D,
+		}, digest)
+	}
 	if err != nil {
 		return nil, err
 	}
+	//the R1 curves in recover mode
+	if key.recover {
+		return recoverableSignature(key.Curve, key.X, key.Y, r, s, digest)
+	}
 	signatureBytes := new(ECDSASignature)
 	signatureBytes.R, signatureBytes.S = r, s
 	return asn1.Marshal(*signatureBytes)
@@ -12,6 +12,7 @@ import (
 	"github.com/meshplus/crypto-standard/asym/secp256k1"
 	"github.com/meshplus/crypto-standard/hash"
 	"math/big"
+	"strconv"
 )
 
 //Algorithm identity
@@ -22,6 +23,8 @@ const (
 	AlgoP384R1        = 0x0400
 	AlgoP521R1        = 0x0500
 	AlgoP256K1Recover = 0x0600
+	AlgoP256R1Recover = 0x0700
+	AlgoP384R1Recover = 0x0800
 	AlgoRSA2048       = 0x1000
 	AlgoRSA3072       = 0x1100
 	AlgoRSA4096       = 0x1200
@@ -34,9 +37,13 @@ type ECDSAPublicKey struct {
 	X, Y    *big.Int
 	recover bool
 	address []byte
+	// compressed makes Bytes return the compressed form
+	compressed bool
+	policy     VerifyPolicy
 }
 
-//FromBytes Parse a public key from 65 bytes and specific algorithm.The reverse method of Bytes()
+//FromBytes Parse a public key from 65 bytes uncompressed or 33 bytes compressed form (for 256 bits curves)
+// and specific algorithm. The reverse method of Bytes()
 func (key *ECDSAPublicKey) FromBytes(k []byte, opt int) error {
 	//in recover mode, the verification does not need to actually pass in a public key
 	//k is a address
@@ -46,55 +53,88 @@ func (key *ECDSAPublicKey) FromBytes(k []byte, opt int) error {
 			key.recover = true
 			key.address = k
 			return nil
-		} else if len(k) == 65 {
+		} else if len(k) == 65 || len(k) == 33 {
 			//txgen配备k1证书时，因历史原因签署的需要为recover的签名
 			opt = AlgoP256K1 //虽然指定了recover，但是传入一个20字节的address，还是看作非recover，这样兼容性较好
 		}
 	}
+	//the same for P-256 and P-384, whose recovery is in pure Go.
+	//a full public key keeps the recover mode, so both forms of signatures verify
+	var recoverMode bool
+	switch opt {
+	case AlgoP256R1, AlgoP256R1Recover, AlgoP384R1, AlgoP384R1Recover:
+		if len(k) == 20 {
+			key.Curve, _ = curveOf(opt)
+			key.recover = true
+			key.address = k
+			return nil
+		}
+		if opt == AlgoP256R1Recover {
+			opt, recoverMode = AlgoP256R1, true
+		} else if opt == AlgoP384R1Recover {
+			opt, recoverMode = AlgoP384R1, true
+		}
+	}
 
 	key.recover = false
 	key.address = nil
+	key.compressed = false
 
-	if key.X == nil {
-		key.X = GetBig()
-	}
-	if key.Y == nil {
-		key.Y = GetBig()
-	}
+	var curve elliptic.Curve
 	switch opt {
 	case AlgoP256K1:
-		if len(k) != 65 {
-			return fmt.Errorf("k length is %v, maybe should use AlgoP256K1Recover", len(k))
-		}
-		key.Curve = secp256k1.S256()
-		key.X.SetBytes(k[1:33])
-		key.Y.SetBytes(k[33:])
+		curve = secp256k1.S256()
 	case AlgoP256R1:
-		if len(k) != 65 {
-			return nil
-		}
-		key.Curve = elliptic.P256()
-		key.X.SetBytes(k[1:33])
-		key.Y.SetBytes(k[33:])
+		curve = elliptic.P256()
 	case AlgoP384R1:
-		if len(k) != 1+(elliptic.P384().Params().BitSize+7)>>2&0x7ffffffffffffffe {
-			return nil
-		}
-		key.Curve = elliptic.P384()
-		key.X.SetBytes(k[1:49])
-		key.Y.SetBytes(k[49:])
+		curve = elliptic.P384()
 	case AlgoP521R1:
-		if len(k) != 1+(elliptic.P521().Params().BitSize+7)>>2&0x7ffffffffffffffe {
-			return nil
+		curve = elliptic.P521()
+	default:
+		return errors.New(errIllegalInputParameter + strconv.Itoa(opt))
+	}
+	byteLen := (curve.Params().BitSize + 7) >> 3
+	var x, y *big.Int
+	switch {
+	case len(k) == 1+2*byteLen:
+		if k[0] != 0x04 {
+			return errors.New("invalid uncompressed public key prefix")
+		}
+		x, y = new(big.Int).SetBytes(k[1:1+byteLen]), new(big.Int).SetBytes(k[1+byteLen:])
+		p := curve.Params().P
+		if x.Cmp(p) >= 0 || y.Cmp(p) >= 0 || !curve.IsOnCurve(x, y) {
+			return errors.New("public key is not on the curve")
 		}
-		key.Curve = elliptic.P521()
-		key.X.SetBytes(k[1:67])
-		key.Y.SetBytes(k[67:])
+	case len(k) == 1+byteLen && (k[0] == 0x02 || k[0] == 0x03):
+		if opt == AlgoP256K1 {
+			var err error
+			if x, y, err = secp256k1.DecompressPubkey(k); err != nil {
+				return err
+			}
+		} else if x, y = elliptic.UnmarshalCompressed(curve, k); x == nil {
+			return errors.New("invalid compressed public key")
+		}
+		key.compressed = true
+	case opt == AlgoP256K1:
+		return fmt.Errorf("k length is %v, maybe should use AlgoP256K1Recover", len(k))
+	default:
+		return fmt.Errorf("k length is %v, want %v or %v", len(k), 1+2*byteLen, 1+byteLen)
+	}
+
+	if key.X == nil {
+		key.X = GetBig()
+	}
+	if key.Y == nil {
+		key.Y = GetBig()
 	}
+	key.Curve = curve
+	key.X.Set(x)
+	key.Y.Set(y)
+	key.recover = recoverMode
 	return nil
 }
 
-//Bytes return key bytes
+//Bytes return key bytes, the compressed form if the key was parsed from it
 func (key *ECDSAPublicKey) Bytes() ([]byte, error) {
 	if key.Y == nil || key.X == nil && len(key.address) == 0 {
 		return nil, errors.New("X or Y is nil")
@@ -106,9 +146,28 @@ func (key *ECDSAPublicKey) Bytes() ([]byte, error) {
 		key.address = address[12:]
 		return key.address, nil
 	}
+	if key.compressed {
+		return key.CompressedBytes()
+	}
 	return tmp, nil
 }
 
+//CompressedBytes return key bytes in the SEC1 compressed form, 0x02 or 0x03 followed by X,
+// 33 bytes for 256 bits curves. libsecp256k1 serializes the secp256k1 keys.
+func (key *ECDSAPublicKey) CompressedBytes() ([]byte, error) {
+	if key.X == nil || key.Y == nil {
+		return nil, errors.New("X or Y is nil")
+	}
+	if key.Curve == secp256k1.S256() {
+		ret := secp256k1.CompressPubkey(key.X, key.Y)
+		if ret == nil {
+			return nil, errors.New("invalid public key")
+		}
+		return ret, nil
+	}
+	return elliptic.MarshalCompressed(key.Curve, key.X, key.Y), nil
+}
+
 func get65BytesPub(X, Y *big.Int, bitsLen int) []byte {
 	x := X.Bytes()
 	y := Y.Bytes()
@@ -121,15 +180,21 @@ func get65BytesPub(X, Y *big.Int, bitsLen int) []byte {
 }
 
 // Verify verify the signature by ECDSAPublicKey self, so the first parameter will be ignored.
+// The signature must satisfy the policy of SetVerifyPolicy.
 func (key *ECDSAPublicKey) Verify(_ []byte, signature, digest []byte) (valid bool, err error) {
+	return key.VerifyWithPolicy(signature, digest, key.policy)
+}
+
+func (key *ECDSAPublicKey) verify(signature, digest []byte) (valid bool, err error) {
 	var signatureBytes ECDSASignature
 	//倾向于是recovery的，也就是用户会把该是recovery=true的错误传入false
-	var recovery = key.Curve == secp256k1.S256() && (key.recover || len(signature) == 65)
+	var recovery = key.recover && key.Curve != nil || key.Curve == secp256k1.S256() && len(signature) == 65 ||
+		(key.Curve == elliptic.P256() || key.Curve == elliptic.P384()) && isRecoverable(key.Curve, signature)
 	var haveXY = key.X != nil && key.Y != nil
 	_, err = asn1.Unmarshal(signature, &signatureBytes)
 	var asn1Form = err == nil
 
-	if !asn1Form && len(signature) != 65 {
+	if !asn1Form && (key.Curve == nil || !isRecoverable(key.Curve, signature)) {
 		return false, fmt.Errorf("worng signature format: %v", hex.EncodeToString(signature))
 	}
 	if !recovery && !haveXY {
@@ -139,7 +204,7 @@ func (key *ECDSAPublicKey) Verify(_ []byte, signature, digest []byte) (valid boo
 	switch {
 	case recovery && asn1Form: //transfer to 65byte
 		if !haveXY {
-			return false, fmt.Errorf("k1: key is recover mod, but signature is asn1From")
+			return false, fmt.Errorf("key is an address, but signature is ASN.1")
 		}
 		fallthrough
 	case !recovery && asn1Form:
@@ -153,16 +218,11 @@ func (key *ECDSAPublicKey) Verify(_ []byte, signature, digest []byte) (valid boo
 		} else {
 			keyOrAddress = key.address
 		}
-		if !recoverVerify(signature, keyOrAddress, digest) {
+		if !recoverVerify(key.Curve, signature, keyOrAddress, digest) {
 			return false, errors.New(errInvalidSignature)
 		}
-	case !recovery && !asn1Form: //65byte
-		if key.Curve == secp256k1.S256() && haveXY {
-			if !recoverVerify(signature, get65BytesPub(key.X, key.Y, key.Params().BitSize), digest) {
-				return false, errors.New(errInvalidSignature)
-			}
-		}
-		return false, fmt.Errorf("k1: key is not recovery mod, but signature is 65bytes")
+	case !recovery && !asn1Form:
+		return false, fmt.Errorf("%v has no recover mode, but signature is %v bytes", key.Params().Name, len(signature))
 	}
 	return true, nil
 }
@@ -175,10 +235,19 @@ func normalVerify(c elliptic.Curve, x, y, r, s *big.Int, digest []byte) bool {
 	}, digest, r, s)
 }
 
-func recoverVerify(signature, keyOrAddr, digest []byte) bool {
-	target, rerr := secp256k1.RecoverPubkey(digest, signature)
-	if rerr != nil {
-		return false
+func recoverVerify(c elliptic.Curve, signature, keyOrAddr, digest []byte) bool {
+	var target []byte
+	if c == secp256k1.S256() {
+		var rerr error
+		if target, rerr = secp256k1.RecoverPubkey(digest, signature); rerr != nil {
+			return false
+		}
+	} else {
+		x, y, rerr := recoverPubkey(c, digest, signature)
+		if rerr != nil {
+			return false
+		}
+		target = get65BytesPub(x, y, c.Params().BitSize)
 	}
 
 	if len(keyOrAddr) == 20 {
@@ -192,6 +261,12 @@ func recoverVerify(signature, keyOrAddr, digest []byte) bool {
 //AlgorithmType return the algorithm type
 func (key *ECDSAPublicKey) AlgorithmType() int {
 	if key.recover {
+		switch key.Curve {
+		case elliptic.P256():
+			return AlgoP256R1Recover
+		case elliptic.P384():
+			return AlgoP384R1Recover
+		}
 		return AlgoP256K1Recover
 	}
 	switch key.Curve {
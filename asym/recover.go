@@ -0,0 +1,126 @@
+package asym
+
+import (
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+)
+
+// The recover mode of the R1 curves works like AlgoP256K1Recover: the signature is r || s || v,
+// 65 bytes for P-256 and 97 bytes for P-384, v is the recovery id. Bit 0 of v is the parity of
+// the y coordinate of the point R = kG, bit 1 is set if the x coordinate of R is r + n.
+
+var errRecoverFailed = errors.New("failed to recover public key")
+
+//RecoverPublicKey recover the public key of the signer from a recoverable signature,
+// opt is AlgoP256K1Recover, AlgoP256R1Recover or AlgoP384R1Recover
+func RecoverPublicKey(opt int, signature, digest []byte) (*ECDSAPublicKey, error) {
+	c, err := curveOf(opt)
+	if err != nil {
+		return nil, err
+	}
+	if !isRecoverable(c, signature) {
+		return nil, errors.New("invalid recoverable signature length")
+	}
+	var x, y *big.Int
+	if c == secp256k1.S256() {
+		pub, err := secp256k1.RecoverPubkey(digest, signature)
+		if err != nil {
+			return nil, err
+		}
+		x, y = new(big.Int).SetBytes(pub[1:33]), new(big.Int).SetBytes(pub[33:])
+	} else if x, y, err = recoverPubkey(c, digest, signature); err != nil {
+		return nil, err
+	}
+	return &ECDSAPublicKey{Curve: c, X: x, Y: y}, nil
+}
+
+// isRecoverable reports whether signature has the r || s || v form of the curve,
+// the lengths of secp256k1 are always taken as recoverable like before
+func isRecoverable(c elliptic.Curve, signature []byte) bool {
+	if len(signature) != 2*((c.Params().BitSize+7)>>3)+1 {
+		return false
+	}
+	if c == secp256k1.S256() {
+		return true
+	}
+	var sig ECDSASignature
+	_, err := asn1.Unmarshal(signature, &sig)
+	return err != nil
+}
+
+// recoverPubkey computes Q = r^-1 (sR - eG) for the R selected by v, in pure Go
+func recoverPubkey(c elliptic.Curve, digest, signature []byte) (x, y *big.Int, err error) {
+	params := c.Params()
+	byteLen := (params.BitSize + 7) >> 3
+	r := new(big.Int).SetBytes(signature[:byteLen])
+	s := new(big.Int).SetBytes(signature[byteLen : 2*byteLen])
+	v := signature[2*byteLen]
+	n := params.N
+	if v > 3 || r.Sign() == 0 || r.Cmp(n) >= 0 || s.Sign() == 0 || s.Cmp(n) >= 0 {
+		return nil, nil, errRecoverFailed
+	}
+
+	rx := new(big.Int).Set(r)
+	if v&2 != 0 {
+		rx.Add(rx, n)
+		if rx.Cmp(params.P) >= 0 {
+			return nil, nil, errRecoverFailed
+		}
+	}
+	compressed := make([]byte, 1+byteLen)
+	compressed[0] = 0x02 | v&1
+	rx.FillBytes(compressed[1:])
+	Rx, Ry := elliptic.UnmarshalCompressed(c, compressed)
+	if Rx == nil {
+		return nil, nil, errRecoverFailed
+	}
+
+	// u1 = -e r^-1, u2 = s r^-1
+	rInv := new(big.Int).ModInverse(r, n)
+	e := hashToInt(digest, c)
+	u1 := e.Neg(e).Mul(e, rInv)
+	u1.Mod(u1, n)
+	u2 := new(big.Int).Mul(s, rInv)
+	u2.Mod(u2, n)
+	x1, y1 := c.ScalarBaseMult(u1.Bytes())
+	x2, y2 := c.ScalarMult(Rx, Ry, u2.Bytes())
+	x, y = c.Add(x1, y1, x2, y2)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, nil, errRecoverFailed
+	}
+	return x, y, nil
+}
+
+// recoverableSignature encodes r || s || v, v is found by recovering the public key x, y
+func recoverableSignature(c elliptic.Curve, x, y, r, s *big.Int, digest []byte) ([]byte, error) {
+	byteLen := (c.Params().BitSize + 7) >> 3
+	ret := make([]byte, 2*byteLen+1)
+	r.FillBytes(ret[:byteLen])
+	s.FillBytes(ret[byteLen : 2*byteLen])
+	for v := byte(0); v < 4; v++ {
+		ret[2*byteLen] = v
+		qx, qy, err := recoverPubkey(c, digest, ret)
+		if err == nil && qx.Cmp(x) == 0 && qy.Cmp(y) == 0 {
+			return ret, nil
+		}
+	}
+	return nil, errRecoverFailed
+}
+
+// hashToInt converts a digest to an integer like crypto/ecdsa, keeping the leftmost bits of the order
+func hashToInt(digest []byte, c elliptic.Curve) *big.Int {
+	orderBits := c.Params().N.BitLen()
+	orderBytes := (orderBits + 7) / 8
+	if len(digest) > orderBytes {
+		digest = digest[:orderBytes]
+	}
+	ret := new(big.Int).SetBytes(digest)
+	if excess := len(digest)*8 - orderBits; excess > 0 {
+		ret.Rsh(ret, uint(excess))
+	}
+	return ret
+}
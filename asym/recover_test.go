@@ -0,0 +1,120 @@
+package asym
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoverR1(t *testing.T) {
+	digest := sha256.Sum256(msg)
+	for _, c := range []struct{ opt, plain, sigLen int }{
+		{AlgoP256R1Recover, AlgoP256R1, 65},
+		{AlgoP384R1Recover, AlgoP384R1, 97},
+	} {
+		priv, err := GenerateKey(c.opt)
+		assert.Nil(t, err)
+		assert.Equal(t, c.opt, priv.AlgorithmType())
+		address, err := priv.Public().(*ECDSAPublicKey).Bytes()
+		assert.Nil(t, err)
+		assert.Equal(t, 20, len(address))
+
+		sig, err := priv.Sign(nil, digest[:], rand.Reader)
+		assert.Nil(t, err)
+		assert.Equal(t, c.sigLen, len(sig))
+
+		// a 20 bytes address with the recover or the plain algorithm type
+		for _, opt := range []int{c.opt, c.plain} {
+			pub := new(ECDSAPublicKey)
+			assert.Nil(t, pub.FromBytes(address, opt))
+			assert.Equal(t, c.opt, pub.AlgorithmType())
+			valid, err := pub.Verify(nil, sig, digest[:])
+			assert.Nil(t, err)
+			assert.True(t, valid)
+			valid, _ = pub.Verify(nil, sig, msg[:32])
+			assert.False(t, valid)
+			tampered := append([]byte{}, sig...)
+			tampered[c.sigLen-1] ^= 1
+			valid, _ = pub.Verify(nil, tampered, digest[:])
+			assert.False(t, valid)
+		}
+
+		// the full public key, uncompressed or compressed, in recover or plain mode
+		r, s, err := ecdsa.Sign(rand.Reader, &ecdsa.PrivateKey{PublicKey: ecdsa.PublicKey{Curve: priv.Curve, X: priv.X, Y: priv.Y}, D: priv.D}, digest[:])
+		assert.Nil(t, err)
+		asn1Sig, _ := asn1.Marshal(ECDSASignature{R: r, S: s})
+		full := get65BytesPub(priv.X, priv.Y, priv.Params().BitSize)
+		compressed := elliptic.MarshalCompressed(priv.Curve, priv.X, priv.Y)
+		for _, opt := range []int{c.opt, c.plain} {
+			for _, k := range [][]byte{full, compressed} {
+				pub := new(ECDSAPublicKey)
+				assert.Nil(t, pub.FromBytes(k, opt))
+				assert.Equal(t, opt, pub.AlgorithmType())
+				for _, signature := range [][]byte{sig, asn1Sig} {
+					valid, err := pub.Verify(nil, signature, digest[:])
+					assert.Nil(t, err)
+					assert.True(t, valid)
+					valid, _ = pub.Verify(nil, signature, msg[:32])
+					assert.False(t, valid)
+				}
+			}
+		}
+
+		recovered, err := RecoverPublicKey(c.opt, sig, digest[:])
+		assert.Nil(t, err)
+		assert.Equal(t, priv.X, recovered.X)
+		assert.Equal(t, priv.Y, recovered.Y)
+
+		// deterministic and low-S signatures keep a valid recovery id
+		priv.SetNonceMode(NonceDeterministic).SetVerifyPolicy(VerifyLowS)
+		for i := 0; i < 8; i++ {
+			d := sha256.Sum256(digest[:i])
+			sig, err = priv.Sign(nil, d[:], nil)
+			assert.Nil(t, err)
+			recovered, err = RecoverPublicKey(c.opt, sig, d[:])
+			assert.Nil(t, err)
+			assert.Equal(t, priv.X, recovered.X)
+		}
+
+		// ECDSA instance
+		k, _ := priv.Bytes()
+		sv := NewECDSA(c.opt)
+		sig, err = sv.Sign(k, digest[:], rand.Reader)
+		assert.Nil(t, err)
+		valid, err := sv.Verify(address, sig, digest[:])
+		assert.Nil(t, err)
+		assert.True(t, valid)
+		valid, err = NewECDSA(c.plain).Verify(address, sig, digest[:])
+		assert.Nil(t, err)
+		assert.True(t, valid)
+	}
+
+	// one of the recovery ids of a crypto/ecdsa signature gives the key back
+	priv, _ := GenerateKey(AlgoP384R1)
+	r, s, err := ecdsa.Sign(rand.Reader, &ecdsa.PrivateKey{PublicKey: ecdsa.PublicKey{Curve: priv.Curve, X: priv.X, Y: priv.Y}, D: priv.D}, digest[:])
+	assert.Nil(t, err)
+	sig, err := recoverableSignature(priv.Curve, priv.X, priv.Y, r, s, digest[:])
+	assert.Nil(t, err)
+	assert.True(t, sig[96] < 4)
+
+	_, err = RecoverPublicKey(AlgoP256R1Recover, sig, digest[:])
+	assert.NotNil(t, err)
+	_, err = RecoverPublicKey(AlgoRSA2048, sig, digest[:])
+	assert.NotNil(t, err)
+}
+
+func TestRecoverK1(t *testing.T) {
+	digest := sha256.Sum256(msg)
+	priv, _ := GenerateKey(AlgoP256K1Recover)
+	sig, err := priv.Sign(nil, digest[:], rand.Reader)
+	assert.Nil(t, err)
+	recovered, err := RecoverPublicKey(AlgoP256K1Recover, sig, digest[:])
+	assert.Nil(t, err)
+	assert.Equal(t, priv.X, recovered.X)
+	assert.Equal(t, priv.Y, recovered.Y)
+}
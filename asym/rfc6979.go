@@ -0,0 +1,151 @@
+package asym
+
+import (
+	std "crypto"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+//NonceMode select how ECDSAPrivateKey.Sign generates the nonce k
+type NonceMode int
+
+//nonce modes
+const (
+	//NonceRandom draw the nonce from the reader of Sign, it is the default
+	NonceRandom NonceMode = iota
+	//NonceDeterministic derive the nonce from the key and the digest (RFC 6979), the reader is not used
+	NonceDeterministic
+	//NonceHedged derive the nonce like NonceDeterministic with 32 bytes of the reader as additional
+	// data (RFC 6979 section 3.6), so a weak reader does not weaken the nonce
+	NonceHedged
+)
+
+// rfc6979 generates the nonces of RFC 6979 section 3.2 for the private key x and the digest,
+// extra is the additional data of section 3.6. The HMAC hash is guessed from the digest length.
+type rfc6979 struct {
+	q    *big.Int
+	qlen int
+	k, v []byte
+	mac  func(data ...[]byte) []byte
+}
+
+// hmacHash returns the SHA1 or SHA2 hash of the length of digest, the signature
+// does not name the HMAC hash, so any choice gives valid signatures
+func hmacHash(digest []byte) (std.Hash, error) {
+	switch len(digest) {
+	case 20:
+		return std.SHA1, nil
+	case 28:
+		return std.SHA224, nil
+	case 32:
+		return std.SHA256, nil
+	case 48:
+		return std.SHA384, nil
+	case 64:
+		return std.SHA512, nil
+	}
+	return 0, fmt.Errorf("rfc6979: unsupported digest length %v", len(digest))
+}
+
+func newRFC6979(c elliptic.Curve, x *big.Int, digest, extra []byte) (*rfc6979, error) {
+	h, err := hmacHash(digest)
+	if err != nil {
+		return nil, err
+	}
+	if !h.Available() {
+		return nil, errors.New("rfc6979: hash is not available")
+	}
+	g := &rfc6979{q: c.Params().N, qlen: c.Params().N.BitLen()}
+	g.mac = func(data ...[]byte) []byte {
+		m := hmac.New(h.New, g.k)
+		for _, d := range data {
+			_, _ = m.Write(d)
+		}
+		return m.Sum(nil)
+	}
+	g.v = make([]byte, h.Size())
+	for i := range g.v {
+		g.v[i] = 0x01
+	}
+	g.k = make([]byte, h.Size())
+	// steps d to g
+	seed := [][]byte{g.int2octets(x), g.bits2octets(digest), extra}
+	g.k = g.mac(append([][]byte{g.v, {0x00}}, seed...)...)
+	g.v = g.mac(g.v)
+	g.k = g.mac(append([][]byte{g.v, {0x01}}, seed...)...)
+	g.v = g.mac(g.v)
+	return g, nil
+}
+
+// next returns the next candidate k in [1, q-1], step h
+func (g *rfc6979) next() *big.Int {
+	for {
+		var t []byte
+		for len(t)*8 < g.qlen {
+			g.v = g.mac(g.v)
+			t = append(t, g.v...)
+		}
+		k := g.bits2int(t)
+		// prepare the next candidate in case k is rejected by the caller
+		g.k = g.mac(g.v, []byte{0x00})
+		g.v = g.mac(g.v)
+		if k.Sign() > 0 && k.Cmp(g.q) < 0 {
+			return k
+		}
+	}
+}
+
+// bits2int keeps the qlen leftmost bits of b, RFC 6979 section 2.3.2
+func (g *rfc6979) bits2int(b []byte) *big.Int {
+	ret := new(big.Int).SetBytes(b)
+	if excess := len(b)*8 - g.qlen; excess > 0 {
+		ret.Rsh(ret, uint(excess))
+	}
+	return ret
+}
+
+// int2octets encodes x on rlen bytes, RFC 6979 section 2.3.3
+func (g *rfc6979) int2octets(x *big.Int) []byte {
+	ret := make([]byte, (g.qlen+7)>>3)
+	b := x.Bytes()
+	copy(ret[len(ret)-len(b):], b)
+	return ret
+}
+
+// bits2octets reduces b modulo q, RFC 6979 section 2.3.4
+func (g *rfc6979) bits2octets(b []byte) []byte {
+	z := g.bits2int(b)
+	if z.Cmp(g.q) >= 0 {
+		z.Sub(z, g.q)
+	}
+	return g.int2octets(z)
+}
+
+// signRFC6979 signs digest with the nonces of RFC 6979
+func signRFC6979(c elliptic.Curve, d *big.Int, digest, extra []byte) (r, s *big.Int, err error) {
+	g, err := newRFC6979(c, d, digest, extra)
+	if err != nil {
+		return nil, nil, err
+	}
+	n := c.Params().N
+	e := g.bits2int(digest)
+	for {
+		k := g.next()
+		r, _ = c.ScalarBaseMult(g.int2octets(k))
+		r.Mod(r, n)
+		if r.Sign() == 0 {
+			continue
+		}
+		// s = k^-1 (e + r d) mod n
+		s = new(big.Int).Mul(r, d)
+		s.Add(s, e)
+		s.Mul(s, k.ModInverse(k, n))
+		s.Mod(s, n)
+		if s.Sign() != 0 {
+			return r, s, nil
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package asym
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRFC6979(t *testing.T) {
+	// RFC 6979 appendix A.2.5, A.2.6 and A.2.7
+	p256 := "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721"
+	p384 := "6b9d3dad2e1b8c1c05b19875b6659f4de23c3b667bf297ba9aa47740787137d896d5724e4c70a825f872c9ea60d2edf5"
+	p521 := "00fad06daa62ba3b25d2fb40133da757205de67f5bb0018fee8c86e1b68c7e75caa896eb32f1f47c70855836a6d16fcc1466f6d8fbec67db89ec0c08b0e996b83538"
+	sha256Sum := func(m string) []byte { s := sha256.Sum256([]byte(m)); return s[:] }
+	sha384Sum := func(m string) []byte { s := sha512.Sum384([]byte(m)); return s[:] }
+	sha512Sum := func(m string) []byte { s := sha512.Sum512([]byte(m)); return s[:] }
+	cases := []struct {
+		opt    int
+		key    string
+		digest []byte
+		r, s   string
+	}{
+		{AlgoP256R1, p256, sha256Sum("sample"),
+			"efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716",
+			"f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8"},
+		{AlgoP256R1, p256, sha256Sum("test"),
+			"f1abb023518351cd71d881567b1ea663ed3efcf6c5132b354f28d3b0b7d38367",
+			"19f4113742a2b14bd25926b49c649155f267e60d3814b4c0cc84250e46f0083"},
+		// the digest is longer than the order
+		{AlgoP256R1, p256, sha512Sum("sample"),
+			"8496a60b5e9b47c825488827e0495b0e3fa109ec4568fd3f8d1097678eb97f00",
+			"2362ab1adbe2b8adf9cb9edab740ea6049c028114f2460f96554f61fae3302fe"},
+		{AlgoP384R1, p384, sha384Sum("sample"),
+			"94edbb92a5ecb8aad4736e56c691916b3f88140666ce9fa73d64c4ea95ad133c81a648152e44acf96e36dd1e80fabe46",
+			"99ef4aeb15f178cea1fe40db2603138f130e740a19624526203b6351d0a3a94fa329c145786e679e7b82c71a38628ac8"},
+		{AlgoP521R1, p521, sha512Sum("sample"),
+			"c328fafcbd79dd77850370c46325d987cb525569fb63c5d3bc53950e6d4c5f174e25a1ee9017b5d450606add152b534931d7d4e8455cc91f9b15bf05ec36e377fa",
+			"617cce7cf5064806c467f678d3b4080d6f1cc50af26ca209417308281b68af282623eaa63e5b5c0723d8b8c37ff0777b1a20f8ccb1dccc43997f1ee0e44da4a67a"},
+	}
+	for _, c := range cases {
+		key := new(ECDSAPrivateKey)
+		k, _ := hex.DecodeString(c.key)
+		assert.Nil(t, key.FromBytes(k, c.opt))
+		sig, err := key.SetNonceMode(NonceDeterministic).Sign(nil, c.digest, nil)
+		assert.Nil(t, err)
+		var parsed ECDSASignature
+		_, err = asn1.Unmarshal(sig, &parsed)
+		assert.Nil(t, err)
+		assert.Equal(t, c.r, parsed.R.Text(16))
+		assert.Equal(t, c.s, parsed.S.Text(16))
+		valid, err := key.Public().(*ECDSAPublicKey).Verify(nil, sig, c.digest)
+		assert.Nil(t, err)
+		assert.True(t, valid)
+
+		// ECDSA instance
+		again, err := (&ECDSA{Opt: c.opt, Nonce: NonceDeterministic}).Sign(k, c.digest, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, sig, again)
+	}
+
+	// hedged with 32 bytes of additional data
+	key := new(ECDSAPrivateKey)
+	k, _ := hex.DecodeString(p256)
+	assert.Nil(t, key.FromBytes(k, AlgoP256R1))
+	extra := make([]byte, 32)
+	for i := range extra {
+		extra[i] = byte(i)
+	}
+	sig, err := key.SetNonceMode(NonceHedged).Sign(nil, sha256Sum("sample"), bytes.NewReader(extra))
+	assert.Nil(t, err)
+	var parsed ECDSASignature
+	_, _ = asn1.Unmarshal(sig, &parsed)
+	assert.Equal(t, "25404cfdb1228f680881e195dae0665f43f988c40cbc4e23927810d7c4635d74", parsed.R.Text(16))
+	assert.Equal(t, "8f076e7b9ea4bde92fb16b5cf25d0d3656db01a6e19c885b53cb8754f1b819c3", parsed.S.Text(16))
+	_, err = key.Sign(nil, sha256Sum("sample"), bytes.NewReader(extra[:8]))
+	assert.NotNil(t, err)
+	_, err = key.SetNonceMode(NonceDeterministic).Sign(nil, extra[:10], nil)
+	assert.NotNil(t, err)
+}
+
+func TestRFC6979K1(t *testing.T) {
+	// private key 1, SHA-256 of "Satoshi Nakamoto", libsecp256k1 returns the low s
+	digest := sha256.Sum256([]byte("Satoshi Nakamoto"))
+	r := "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8"
+	s := "2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5"
+
+	key := new(ECDSAPrivateKey)
+	assert.Nil(t, key.FromBytes([]byte{1}, AlgoP256K1Recover))
+	sig, err := key.SetNonceMode(NonceDeterministic).Sign(nil, digest[:], nil)
+	assert.Nil(t, err)
+	assert.Equal(t, r+s, hex.EncodeToString(sig[:64]))
+	valid, err := key.Public().(*ECDSAPublicKey).Verify(nil, sig, digest[:])
+	assert.Nil(t, err)
+	assert.True(t, valid)
+
+	hedged, err := key.SetNonceMode(NonceHedged).Sign(nil, digest[:], bytes.NewReader(make([]byte, 32)))
+	assert.Nil(t, err)
+	assert.NotEqual(t, sig, hedged)
+	valid, _ = key.Public().(*ECDSAPublicKey).Verify(nil, hedged, digest[:])
+	assert.True(t, valid)
+
+	// the Go path of AlgoP256K1 does not normalize s
+	key = new(ECDSAPrivateKey)
+	assert.Nil(t, key.FromBytes([]byte{1}, AlgoP256K1))
+	der, err := key.SetNonceMode(NonceDeterministic).Sign(nil, digest[:], nil)
+	assert.Nil(t, err)
+	var parsed ECDSASignature
+	_, _ = asn1.Unmarshal(der, &parsed)
+	assert.Equal(t, r, parsed.R.Text(16))
+	low, _ := new(big.Int).SetString(s, 16)
+	assert.True(t, parsed.S.Cmp(low) == 0 || new(big.Int).Add(parsed.S, low).Cmp(secp256k1.N) == 0)
+}
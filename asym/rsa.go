@@ -0,0 +1,266 @@
+package asym
+
+import (
+	std "crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+//RSA signature schemes, combined with AlgoRSA2048, AlgoRSA3072 or AlgoRSA4096, e.g. AlgoRSA2048|RSAPSS
+const (
+	RSAPKCS1v15 = 0x00
+	RSAPSS      = 0x01
+)
+
+// rsaSchemeMask selects the signature scheme bits of an RSA algorithm type
+const rsaSchemeMask = 0xff
+
+//RSAPublicKey RSA public key, the algorithm type records the modulus size and the signature scheme
+type RSAPublicKey struct {
+	rsa.PublicKey
+	opt int
+	// hashType is the hash of the digests, see SetHashType
+	hashType hash.HashType
+}
+
+//RSAPrivateKey RSA private key
+type RSAPrivateKey struct {
+	RSAPublicKey
+	key *rsa.PrivateKey
+}
+
+//IsRSA report whether opt is an RSA algorithm type
+func IsRSA(opt int) bool {
+	return rsaBits(opt) != 0
+}
+
+// rsaBits returns the modulus size of an RSA algorithm type, or 0
+func rsaBits(opt int) int {
+	if opt&rsaSchemeMask > RSAPSS {
+		return 0
+	}
+	switch opt &^ rsaSchemeMask {
+	case AlgoRSA2048:
+		return 2048
+	case AlgoRSA3072:
+		return 3072
+	case AlgoRSA4096:
+		return 4096
+	}
+	return 0
+}
+
+// pssHashes are the hash types PSS supports, crypto/rsa hashes the MGF1 of them itself
+var pssHashes = map[hash.HashType]std.Hash{
+	hash.SHA1:     std.SHA1,
+	hash.SHA2_224: std.SHA224,
+	hash.SHA2_256: std.SHA256,
+	hash.SHA2_384: std.SHA384,
+	hash.SHA2_512: std.SHA512,
+}
+
+// checkDigest returns an error if hashType is not set or unknown or digest is not its output
+func checkDigest(hashType hash.HashType, digest []byte) error {
+	if hashType == 0 {
+		return errors.New("rsa: the hash type of the digest is not set")
+	}
+	h := hash.NewHasher(hashType)
+	if h == nil {
+		return hash.UnsupportedHashError(hashType)
+	}
+	if len(digest) != h.Size() {
+		return fmt.Errorf("rsa: digest length is %v, want %v for %v", len(digest), h.Size(), hashType)
+	}
+	return nil
+}
+
+// digestInfo returns the DigestInfo of PKCS#1 v1.5 which names hashType by its OID
+func digestInfo(hashType hash.HashType, digest []byte) ([]byte, error) {
+	if err := checkDigest(hashType, digest); err != nil {
+		return nil, err
+	}
+	oid := hashType.OID()
+	if oid == nil {
+		return nil, fmt.Errorf("rsa: %v has no OID for PKCS#1 v1.5", hashType)
+	}
+	return asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		Digest    []byte
+	}{pkix.AlgorithmIdentifier{Algorithm: oid, Parameters: asn1.NullRawValue}, digest})
+}
+
+// pssHash returns the hash of PSS for hashType
+func pssHash(hashType hash.HashType, digest []byte) (std.Hash, error) {
+	if err := checkDigest(hashType, digest); err != nil {
+		return 0, err
+	}
+	h, ok := pssHashes[hashType]
+	if !ok {
+		return 0, fmt.Errorf("rsa: PSS does not support %v", hashType)
+	}
+	return h, nil
+}
+
+//GenerateRSAKey generate an RSA key, input is AlgoRSA2048, AlgoRSA3072 or AlgoRSA4096,
+// optionally combined with RSAPSS
+func GenerateRSAKey(opt int) (*RSAPrivateKey, error) {
+	bits := rsaBits(opt)
+	if bits == 0 {
+		return nil, errors.New(errIllegalInputParameter + strconv.Itoa(opt))
+	}
+	k, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, err
+	}
+	return &RSAPrivateKey{RSAPublicKey: RSAPublicKey{PublicKey: k.PublicKey, opt: opt}, key: k}, nil
+}
+
+//Bytes return the PKCS#1 DER encoding of the key. Inverse method of FromBytes(k []byte, opt int)
+func (key *RSAPrivateKey) Bytes() ([]byte, error) {
+	if key.key == nil {
+		return nil, errors.New("RSAPrivateKey.key is nil, please invoke FromBytes()")
+	}
+	return x509.MarshalPKCS1PrivateKey(key.key), nil
+}
+
+//FromBytes parse a PKCS#1 DER private key, the modulus size must match opt. Inverse method of Bytes()
+func (key *RSAPrivateKey) FromBytes(k []byte, opt int) error {
+	bits := rsaBits(opt)
+	if bits == 0 {
+		return errors.New(errIllegalInputParameter + strconv.Itoa(opt))
+	}
+	priv, err := x509.ParsePKCS1PrivateKey(k)
+	if err != nil {
+		return err
+	}
+	if priv.N.BitLen() != bits {
+		return fmt.Errorf("rsa: key size is %v, want %v", priv.N.BitLen(), bits)
+	}
+	key.key = priv
+	key.RSAPublicKey = RSAPublicKey{PublicKey: priv.PublicKey, opt: opt, hashType: key.hashType}
+	return nil
+}
+
+//SetHashType set the hash of the digests to sign, it is named in the DigestInfo of PKCS#1 v1.5
+// and is the hash of PSS. The public key of Public shares it.
+func (key *RSAPrivateKey) SetHashType(hashType hash.HashType) *RSAPrivateKey {
+	key.hashType = hashType
+	return key
+}
+
+//Public return the RSAPublicKey of the private key
+func (key *RSAPrivateKey) Public() std.PublicKey {
+	return &key.RSAPublicKey
+}
+
+//Sign get signature of specific digest by RSAPrivateKey self, so the first parameter will be ignored.
+// The scheme is PKCS#1 v1.5 or PSS with a salt as long as the digest. digest is the output of the
+// hash of SetHashType, any hash with an OID for PKCS#1 v1.5, SHA1 or SHA2 for PSS.
+func (key *RSAPrivateKey) Sign(_, digest []byte, reader io.Reader) (signature []byte, err error) {
+	if key.key == nil {
+		return nil, errors.New("RSAPrivateKey.key is nil, please invoke FromBytes()")
+	}
+	if reader == nil {
+		reader = rand.Reader
+	}
+	if key.opt&rsaSchemeMask == RSAPSS {
+		h, err := pssHash(key.hashType, digest)
+		if err != nil {
+			return nil, err
+		}
+		return rsa.SignPSS(reader, key.key, h, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	}
+	info, err := digestInfo(key.hashType, digest)
+	if err != nil {
+		return nil, err
+	}
+	// the hash 0 signs the DigestInfo as is
+	return rsa.SignPKCS1v15(reader, key.key, 0, info)
+}
+
+//Bytes return the PKCS#1 DER encoding of the key
+func (key *RSAPublicKey) Bytes() ([]byte, error) {
+	if key.N == nil {
+		return nil, errors.New("N is nil")
+	}
+	return x509.MarshalPKCS1PublicKey(&key.PublicKey), nil
+}
+
+//FromBytes parse a PKCS#1 or PKIX DER public key, the modulus size must match opt. The reverse method of Bytes()
+func (key *RSAPublicKey) FromBytes(k []byte, opt int) error {
+	bits := rsaBits(opt)
+	if bits == 0 {
+		return errors.New(errIllegalInputParameter + strconv.Itoa(opt))
+	}
+	pub, err := parseRSAPublicKey(k)
+	if err != nil {
+		return err
+	}
+	if pub.N.BitLen() != bits {
+		return fmt.Errorf("rsa: key size is %v, want %v", pub.N.BitLen(), bits)
+	}
+	key.PublicKey, key.opt = *pub, opt
+	return nil
+}
+
+//SetHashType set the hash of the digests to verify, see RSAPrivateKey.SetHashType
+func (key *RSAPublicKey) SetHashType(hashType hash.HashType) *RSAPublicKey {
+	key.hashType = hashType
+	return key
+}
+
+// parseRSAPublicKey parses a PKCS#1 RSAPublicKey or a PKIX SubjectPublicKeyInfo
+func parseRSAPublicKey(k []byte) (*rsa.PublicKey, error) {
+	if pub, err := x509.ParsePKCS1PublicKey(k); err == nil {
+		return pub, nil
+	}
+	pub, err := x509.ParsePKIXPublicKey(k)
+	if err != nil {
+		return nil, err
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("rsa: PKIX key is not an RSA key")
+	}
+	return rsaPub, nil
+}
+
+//Verify verify the signature by RSAPublicKey self, so the first parameter will be ignored.
+// digest is the output of the hash of SetHashType, PSS signatures of any salt length are accepted.
+func (key *RSAPublicKey) Verify(_ []byte, signature, digest []byte) (valid bool, err error) {
+	if key.N == nil {
+		return false, fmt.Errorf("key is empty")
+	}
+	if key.opt&rsaSchemeMask == RSAPSS {
+		h, err := pssHash(key.hashType, digest)
+		if err != nil {
+			return false, err
+		}
+		if rsa.VerifyPSS(&key.PublicKey, h, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto}) != nil {
+			return false, errors.New(errInvalidSignature)
+		}
+		return true, nil
+	}
+	info, err := digestInfo(key.hashType, digest)
+	if err != nil {
+		return false, err
+	}
+	if rsa.VerifyPKCS1v15(&key.PublicKey, 0, info, signature) != nil {
+		return false, errors.New(errInvalidSignature)
+	}
+	return true, nil
+}
+
+//AlgorithmType return the algorithm type
+func (key *RSAPublicKey) AlgorithmType() int {
+	return key.opt
+}
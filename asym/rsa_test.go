@@ -0,0 +1,150 @@
+package asym
+
+import (
+	std "crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/meshplus/crypto-standard/hash/sha3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRSA(t *testing.T) {
+	key, err := GenerateRSAKey(AlgoRSA2048)
+	assert.Nil(t, err)
+	priv, err := key.Bytes()
+	assert.Nil(t, err)
+	pub, err := key.Public().(*RSAPublicKey).Bytes()
+	assert.Nil(t, err)
+	digest := sha256.Sum256(msg)
+
+	for _, opt := range []int{AlgoRSA2048, AlgoRSA2048 | RSAPSS} {
+		sv := &ECDSA{Opt: opt, HashType: hash.SHA2_256}
+		sig, err := sv.Sign(priv, digest[:], rand.Reader)
+		assert.Nil(t, err)
+		assert.Equal(t, 256, len(sig))
+		valid, err := sv.Verify(pub, sig, digest[:])
+		assert.Nil(t, err)
+		assert.True(t, valid)
+
+		parsed := new(RSAPublicKey)
+		assert.Nil(t, parsed.FromBytes(pub, opt))
+		assert.Equal(t, opt, parsed.AlgorithmType())
+		if opt == AlgoRSA2048 {
+			assert.Nil(t, rsa.VerifyPKCS1v15(&parsed.PublicKey, std.SHA256, digest[:], sig))
+		} else {
+			assert.Nil(t, rsa.VerifyPSS(&parsed.PublicKey, std.SHA256, digest[:], sig, nil))
+		}
+
+		sig[0] ^= 1
+		valid, err = sv.Verify(pub, sig, digest[:])
+		assert.NotNil(t, err)
+		assert.False(t, valid)
+	}
+
+	// the scheme of the key decides, a PSS signature is not a PKCS#1 v1.5 one
+	sig, err := (&ECDSA{Opt: AlgoRSA2048 | RSAPSS, HashType: hash.SHA2_256}).Sign(priv, digest[:], nil)
+	assert.Nil(t, err)
+	valid, _ := (&ECDSA{Opt: AlgoRSA2048, HashType: hash.SHA2_256}).Verify(pub, sig, digest[:])
+	assert.False(t, valid)
+
+	// the hash is not guessed from the digest length
+	_, err = NewECDSA(AlgoRSA2048).Sign(priv, digest[:], nil)
+	assert.NotNil(t, err)
+	_, err = key.Sign(nil, digest[:], nil)
+	assert.NotNil(t, err)
+
+	// PKCS#1 v1.5 is deterministic and names the hash of SetHashType
+	d512 := sha512.Sum512(msg)
+	sig, err = key.SetHashType(hash.SHA2_512).Sign(nil, d512[:], nil)
+	assert.Nil(t, err)
+	again, _ := key.Sign(nil, d512[:], nil)
+	assert.Equal(t, sig, again)
+	assert.Nil(t, rsa.VerifyPKCS1v15(&key.PublicKey, std.SHA512, d512[:], sig))
+	valid, err = key.Public().(*RSAPublicKey).Verify(nil, sig, d512[:])
+	assert.Nil(t, err)
+	assert.True(t, valid)
+	_, err = key.Sign(nil, d512[:10], nil)
+	assert.NotNil(t, err)
+
+	// a SHA3-256 digest is not a SHA2-256 one
+	d3, _ := hash.Sum(hash.SHA3_256, msg)
+	sig, err = key.SetHashType(hash.SHA3_256).Sign(nil, d3, nil)
+	assert.Nil(t, err)
+	prefix, _ := hex.DecodeString("3031300d060960864801650304020805000420")
+	assert.Nil(t, rsa.VerifyPKCS1v15(&key.PublicKey, 0, append(prefix, d3...), sig))
+	assert.NotNil(t, rsa.VerifyPKCS1v15(&key.PublicKey, std.SHA256, d3, sig))
+	valid, _ = key.Public().(*RSAPublicKey).SetHashType(hash.SHA2_256).Verify(nil, sig, d3)
+	assert.False(t, valid)
+	// Keccak has no OID, PSS supports SHA1 and SHA2 only
+	_, err = key.SetHashType(hash.KECCAK_256).Sign(nil, d3, nil)
+	assert.NotNil(t, err)
+	pss := new(RSAPrivateKey).SetHashType(hash.SHA3_256)
+	assert.Nil(t, pss.FromBytes(priv, AlgoRSA2048|RSAPSS))
+	_, err = pss.Sign(nil, d3, nil)
+	assert.NotNil(t, err)
+	_, err = pss.SetHashType(hash.HashType(0xff)).Sign(nil, d3, nil)
+	assert.NotNil(t, err)
+
+	assert.NotNil(t, new(RSAPrivateKey).FromBytes(priv, AlgoRSA3072))
+	assert.NotNil(t, new(RSAPublicKey).FromBytes(pub, AlgoRSA4096))
+	assert.NotNil(t, new(RSAPublicKey).FromBytes(pub, AlgoP256R1))
+	_, err = NewECDSA(AlgoRSA3072).Sign(priv, digest[:], nil)
+	assert.NotNil(t, err)
+	_, err = GenerateRSAKey(AlgoRSA2048 | 0x02)
+	assert.NotNil(t, err)
+	assert.True(t, IsRSA(AlgoRSA4096|RSAPSS))
+	assert.False(t, IsRSA(AlgoP256K1))
+}
+
+func TestRSAOAEP(t *testing.T) {
+	key, err := GenerateRSAKey(AlgoRSA2048)
+	assert.Nil(t, err)
+	priv, _ := key.Bytes()
+	pkcs1, _ := key.Public().(*RSAPublicKey).Bytes()
+	pkix, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.Nil(t, err)
+	sessionKey := msg[:32]
+
+	for _, k := range [][]byte{pkcs1, pkix} {
+		o := NewRSAOAEP(hash.SHA3_256, []byte("label"))
+		c, err := o.Encrypt(k, sessionKey, rand.Reader)
+		assert.Nil(t, err)
+		assert.Equal(t, 256, len(c))
+		p, err := o.Decrypt(priv, c)
+		assert.Nil(t, err)
+		assert.Equal(t, sessionKey, p)
+		p, err = rsa.DecryptOAEP(sha3.New256(), nil, key.key, c, []byte("label"))
+		assert.Nil(t, err)
+		assert.Equal(t, sessionKey, p)
+
+		_, err = NewRSAOAEP(hash.SHA3_256, nil).Decrypt(priv, c)
+		assert.NotNil(t, err)
+		_, err = NewRSAOAEP(hash.SHA2_256, []byte("label")).Decrypt(priv, c)
+		assert.NotNil(t, err)
+	}
+
+	// the key types of the AlgoRSA identifiers
+	pub := new(RSAPublicKey)
+	assert.Nil(t, pub.FromBytes(pkix, AlgoRSA2048))
+	c, err := pub.Encrypt(hash.SHA2_256, sessionKey, nil, nil)
+	assert.Nil(t, err)
+	p, err := key.Decrypt(hash.SHA2_256, c, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, sessionKey, p)
+	p, err = rsa.DecryptOAEP(sha256.New(), nil, key.key, c, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, sessionKey, p)
+
+	// 256 - 2*64 - 2 bytes at most with SHA2-512
+	_, err = pub.Encrypt(hash.SHA2_512, msg[:127], nil, nil)
+	assert.NotNil(t, err)
+	_, err = pub.Encrypt(hash.HashType(0xff), sessionKey, nil, nil)
+	assert.NotNil(t, err)
+}
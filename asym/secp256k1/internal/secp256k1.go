@@ -62,6 +62,7 @@ var (
 	ErrInvalidMsgLen       = errors.New("invalid message length for signature recovery")
 	ErrInvalidSignatureLen = errors.New("invalid signature length")
 	ErrInvalidRecoveryID   = errors.New("invalid signature recovery id")
+	ErrInvalidPubkey       = errors.New("invalid public key")
 )
 
 func init() {
@@ -163,6 +164,47 @@ func Sign(msg []byte, seckey []byte, rand io.Reader) ([]byte, error) {
 	if ret == C.int(0) {
 		return Sign(msg, seckey, rand) //invalid secret, try again
 	}
+	return serializeRecoverable(sig), nil
+}
+
+//SignRFC6979 return a 65 bytes recoverable signature with the RFC 6979 nonce of libsecp256k1,
+// extra is nil for a deterministic signature or 32 bytes of additional data (RFC 6979 section 3.6)
+func SignRFC6979(msg []byte, seckey []byte, extra []byte) ([]byte, error) {
+	if len(msg) != 32 {
+		return nil, ErrInvalidMsgLen
+	}
+	if extra != nil && len(extra) != 32 {
+		return nil, errors.New("invalid additional data length")
+	}
+	msgPtr := (*C.uchar)(unsafe.Pointer(&msg[0]))
+	seckeyPtr := (*C.uchar)(unsafe.Pointer(&seckey[0]))
+	sig := make([]byte, 65)
+	sigPtr := (*C.secp256k1_ecdsa_recoverable_signature)(unsafe.Pointer(&sig[0]))
+	var ndataPtr unsafe.Pointer
+	if extra != nil {
+		ndataPtr = unsafe.Pointer(&extra[0])
+	}
+
+	if C.dm_secp256k1_ec_seckey_verify(context, seckeyPtr) != C.int(1) {
+		return nil, errors.New("Invalid secret key")
+	}
+	ret := C.dm_secp256k1_ecdsa_sign_recoverable(
+		context,
+		sigPtr,
+		msgPtr,
+		seckeyPtr,
+		nil, // the default RFC 6979 nonce function
+		ndataPtr,
+	)
+	if ret == C.int(0) {
+		return nil, errors.New("failed to sign")
+	}
+	return serializeRecoverable(sig), nil
+}
+
+// serializeRecoverable converts a recoverable signature to r || s || v
+func serializeRecoverable(sig []byte) []byte {
+	sigPtr := (*C.secp256k1_ecdsa_recoverable_signature)(unsafe.Pointer(&sig[0]))
 
 	sigSerialized := make([]byte, 65)
 	sigSerializedPtr := (*C.uchar)(unsafe.Pointer(&sigSerialized[0]))
@@ -177,8 +219,7 @@ func Sign(msg []byte, seckey []byte, rand io.Reader) ([]byte, error) {
 
 	sigSerialized[64] = byte(int(recid)) // add back recid to get 65 bytes sig
 
-	return sigSerialized, nil
-
+	return sigSerialized
 }
 
 // RecoverPubkey returns the the public key of the signer.
@@ -238,6 +279,34 @@ func RecoverPubkey(msg []byte, sig []byte) ([]byte, error) {
 	return bytes65, nil
 }
 
+//ReencodePubkey parses a 33 bytes compressed or 65 bytes uncompressed public key, which must
+// be on the curve, and serializes it in the compressed or uncompressed form
+func ReencodePubkey(pubkey []byte, compressed bool) ([]byte, error) {
+	if len(pubkey) != 33 && len(pubkey) != 65 || len(pubkey) == 33 && pubkey[0] != 0x02 && pubkey[0] != 0x03 ||
+		len(pubkey) == 65 && pubkey[0] != 0x04 {
+		return nil, ErrInvalidPubkey
+	}
+	parsed := make([]byte, 64)
+	parsedPtr := (*C.secp256k1_pubkey)(unsafe.Pointer(&parsed[0]))
+	if C.dm_secp256k1_ec_pubkey_parse(context, parsedPtr, (*C.uchar)(unsafe.Pointer(&pubkey[0])), C.size_t(len(pubkey))) == 0 {
+		return nil, ErrInvalidPubkey
+	}
+	out := make([]byte, 65)
+	outLen := C.size_t(len(out))
+	flags := C.uint(0)
+	if compressed {
+		flags = C.SECP256K1_EC_COMPRESSED
+	}
+	C.dm_secp256k1_ec_pubkey_serialize( // always returns 1
+		context,
+		(*C.uchar)(unsafe.Pointer(&out[0])),
+		&outLen,
+		parsedPtr,
+		flags,
+	)
+	return out[:outLen], nil
+}
+
 func checkSignature(sig []byte) error {
 	if len(sig) != 65 {
 		return ErrInvalidSignatureLen
@@ -24,6 +24,12 @@ func Sign(msg []byte, seckey []byte, rand io.Reader) ([]byte, error) {
 	return internal.Sign(msg, seckey, rand)
 }
 
+//SignRFC6979 return a recoverable signature with a RFC 6979 nonce, extra is nil for
+// a deterministic signature or 32 bytes of additional data for a hedged one
+func SignRFC6979(msg []byte, seckey []byte, extra []byte) ([]byte, error) {
+	return internal.SignRFC6979(msg, seckey, extra)
+}
+
 // RecoverPubkey returns the the public key of the signer.
 // msg must be the 32-byte hash of the message to be signed.
 // sig must be a 65-byte compact ECDSA signature containing the
@@ -31,3 +37,32 @@ func Sign(msg []byte, seckey []byte, rand io.Reader) ([]byte, error) {
 func RecoverPubkey(msg []byte, sig []byte) ([]byte, error) {
 	return internal.RecoverPubkey(msg, sig)
 }
+
+//CompressPubkey return the 33 bytes compressed form of a point, serialized by libsecp256k1.
+// It returns nil if the point is not on the curve.
+func CompressPubkey(x, y *big.Int) []byte {
+	if x.BitLen() > 256 || y.BitLen() > 256 {
+		return nil
+	}
+	pub := make([]byte, 65)
+	pub[0] = 0x04
+	x.FillBytes(pub[1:33])
+	y.FillBytes(pub[33:])
+	ret, err := internal.ReencodePubkey(pub, true)
+	if err != nil {
+		return nil
+	}
+	return ret
+}
+
+//DecompressPubkey parse a 33 bytes compressed public key, the point is on the curve
+func DecompressPubkey(pubkey []byte) (x, y *big.Int, err error) {
+	if len(pubkey) != 33 {
+		return nil, nil, internal.ErrInvalidPubkey
+	}
+	pub, err := internal.ReencodePubkey(pubkey, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return new(big.Int).SetBytes(pub[1:33]), new(big.Int).SetBytes(pub[33:]), nil
+}
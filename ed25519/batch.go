@@ -0,0 +1,161 @@
+package ed25519
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"crypto/sha512"
+	"io"
+
+	"github.com/meshplus/crypto-standard/ed25519/curve25519"
+	"github.com/meshplus/crypto-standard/ed25519/ge25519"
+	"github.com/meshplus/crypto-standard/ed25519/modm"
+)
+
+// identity is the encoding of the neutral element
+var identity = [32]byte{1}
+
+// lMinus1 is l-1 for the order l of the base point, little endian
+var lMinus1 = [32]byte{0xec, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14, 31: 0x10}
+
+type batchEntry struct {
+	index                   int
+	publicKey, message, sig []byte
+	negA, negR              ge25519.Ge25519
+	hram, s                 modm.Bignum256
+}
+
+//VerifyBatch reports whether signatures[i] is a valid signature of messages[i] by keys[i] for every i,
+// the result is always the one of Verify.
+// The signatures are checked together with the random linear combination of the cofactored
+// equations [8][S]B = [8]R + [8][k]A, a failed batch is split in halves until a single signature
+// is left, which is checked by Verify. The cofactored and the cofactorless equation of Verify only
+// differ if R or A has a small order component, so such a signature never enters the batch: it is
+// checked by Verify if A has one, and rejected if only R has one, as SB - kA is then in the prime
+// order subgroup and can not be R.
+func VerifyBatch(keys []*EDDSAPublicKey, messages, signatures [][]byte) []bool {
+	ret := make([]bool, len(keys))
+	entries := make([]batchEntry, 0, len(keys))
+	// a key is often used for several signatures, its subgroup check is done once
+	torsionFreeKeys := make(map[EDDSAPublicKey]bool)
+	for i := range keys {
+		var e batchEntry
+		if !e.parse(keys[i][:], messages[i], signatures[i]) {
+			continue
+		}
+		free, ok := torsionFreeKeys[*keys[i]]
+		if !ok {
+			free = torsionFree(&e.negA)
+			torsionFreeKeys[*keys[i]] = free
+		}
+		switch {
+		case !free:
+			ret[i] = verify(e.publicKey, e.message, e.sig)
+		case torsionFree(&e.negR):
+			e.index = i
+			entries = append(entries, e)
+		}
+	}
+	verifyEntries(entries, ret)
+	return ret
+}
+
+// parse rejects what verify rejects before the curve arithmetic, and the non canonical R
+func (e *batchEntry) parse(publicKey, message, sig []byte) bool {
+	var (
+		hash  [64]byte
+		check [32]byte
+	)
+	if len(sig) != EddsaSignLen || (sig[63]&224 != 0) || !scMinimal(sig[32:]) ||
+		!ge25519.UnpackVartime(&e.negA, publicKey, true) || !ge25519.UnpackVartime(&e.negR, sig[:32], false) {
+		return false
+	}
+	ge25519.Pack(check[:], &e.negR)
+	if !bytes.Equal(check[:], sig[:32]) {
+		return false
+	}
+	negate(&e.negR)
+	e.publicKey, e.message, e.sig = publicKey, message, sig
+
+	h := sha512.New()
+	_, _ = h.Write(sig[:32])
+	_, _ = h.Write(publicKey)
+	_, _ = h.Write(message)
+	h.Sum(hash[:0])
+	modm.Expand(&e.hram, hash[:])
+	modm.Expand(&e.s, sig[32:])
+	return true
+}
+
+func negate(p *ge25519.Ge25519) {
+	var t curve25519.Bignum25519
+	curve25519.Copy(&t, &p.X)
+	curve25519.Neg(&p.X, &t)
+	curve25519.Copy(&t, &p.T)
+	curve25519.Neg(&p.T, &t)
+}
+
+// torsionFree reports whether p is in the subgroup of order l, that is [l-1]p == -p
+func torsionFree(p *ge25519.Ge25519) bool {
+	var (
+		s, zero modm.Bignum256
+		r, neg  ge25519.Ge25519
+		a, b    [32]byte
+	)
+	modm.Expand(&s, lMinus1[:])
+	ge25519.DoubleScalarmultVartime(&r, p, &s, &zero)
+	ge25519.Pack(a[:], &r)
+	neg = *p
+	negate(&neg)
+	ge25519.Pack(b[:], &neg)
+	return a == b
+}
+
+func verifyEntries(entries []batchEntry, ret []bool) {
+	switch len(entries) {
+	case 0:
+		return
+	case 1:
+		e := &entries[0]
+		ret[e.index] = verify(e.publicKey, e.message, e.sig)
+		return
+	}
+	if batchEquation(entries) {
+		for i := range entries {
+			ret[entries[i].index] = true
+		}
+		return
+	}
+	half := len(entries) / 2
+	verifyEntries(entries[:half], ret)
+	verifyEntries(entries[half:], ret)
+}
+
+// batchEquation checks [8]([sum z*S]B - sum [z]R - sum [z*k]A) == 0 with random 128 bits z
+func batchEquation(entries []batchEntry) bool {
+	var (
+		base, z, zs modm.Bignum256
+		r           ge25519.Ge25519
+		packed      [32]byte
+		points      = make([]ge25519.Ge25519, 0, 2*len(entries))
+		scalars     = make([]modm.Bignum256, 0, 2*len(entries))
+		random      = make([]byte, 16*len(entries))
+	)
+	if _, err := io.ReadFull(cryptorand.Reader, random); err != nil {
+		return false
+	}
+	for i := range entries {
+		e := &entries[i]
+		modm.Expand(&z, random[16*i:16*i+16])
+		modm.Mul(&zs, &z, &e.s)
+		modm.Add(&base, &base, &zs)
+		points = append(points, e.negR, e.negA)
+		scalars = append(scalars, z, modm.Bignum256{})
+		modm.Mul(&scalars[len(scalars)-1], &z, &e.hram)
+	}
+	ge25519.MultiScalarmultVartime(&r, points, scalars, &base)
+	ge25519.Double(&r, &r)
+	ge25519.Double(&r, &r)
+	ge25519.Double(&r, &r)
+	ge25519.Pack(packed[:], &r)
+	return packed == identity
+}
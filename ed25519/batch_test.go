@@ -0,0 +1,152 @@
+package ed25519
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/ed25519/ge25519"
+	"github.com/meshplus/crypto-standard/ed25519/modm"
+	"github.com/stretchr/testify/assert"
+)
+
+func batchInput(n int) ([]*EDDSAPublicKey, [][]byte, [][]byte) {
+	keys := make([]*EDDSAPublicKey, n)
+	messages := make([][]byte, n)
+	signatures := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		sk, pk := GenerateKey(nil)
+		keys[i] = pk
+		messages[i] = []byte("message " + strconv.Itoa(i))
+		signatures[i], _ = sk.Sign(nil, messages[i], nil)
+	}
+	return keys, messages, signatures
+}
+
+func TestVerifyBatch(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 64} {
+		keys, messages, signatures := batchInput(n)
+		for _, valid := range VerifyBatch(keys, messages, signatures) {
+			assert.True(t, valid)
+		}
+		if n == 0 {
+			continue
+		}
+
+		// the bad signatures are pinpointed
+		bad := map[int]bool{0: true, n / 2: true, n - 1: true}
+		for i := range bad {
+			switch i % 3 {
+			case 0:
+				signatures[i] = append([]byte{}, signatures[i]...)
+				signatures[i][40] ^= 1
+			case 1:
+				messages[i] = []byte("tampered")
+			default:
+				signatures[i] = signatures[i][:63]
+			}
+		}
+		result := VerifyBatch(keys, messages, signatures)
+		for i, valid := range result {
+			assert.Equal(t, !bad[i], valid, "n=%d i=%d", n, i)
+			single, _ := keys[i].Verify(nil, signatures[i], messages[i])
+			assert.Equal(t, single, valid)
+		}
+	}
+
+	// the RFC 8032 vectors
+	keys := make([]*EDDSAPublicKey, len(testDate))
+	messages := make([][]byte, len(testDate))
+	signatures := make([][]byte, len(testDate))
+	for i := range testDate {
+		keys[i] = (*EDDSAPublicKey)(&testDate[i].pk)
+		messages[i] = []byte(testDate[i].msg)
+		signatures[i] = testDate[i].sign[:]
+	}
+	for _, valid := range VerifyBatch(keys, messages, signatures) {
+		assert.True(t, valid)
+	}
+}
+
+// signWithTorsion signs msg with R = rB + [rt]T and A = aB + [at]T, T of order 8
+func signWithTorsion(t *testing.T, msg []byte, rt, at int) (*EDDSAPublicKey, []byte) {
+	order8, _ := hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	sk, _ := GenerateKey(nil)
+	extsk := sha512.Sum512(sk[:32])
+	extsk[0] &= 248
+	extsk[31] &= 127
+	extsk[31] |= 64
+
+	var (
+		r, a, k, s modm.Bignum256
+		R, A, T    ge25519.Ge25519
+		pk         EDDSAPublicKey
+		sig        [64]byte
+	)
+	modm.Expand(&r, append([]byte("a nonce"), msg...))
+	modm.Expand(&a, extsk[:32])
+	ge25519.ScalarmultBaseNiels(&R, &r)
+	ge25519.ScalarmultBaseNiels(&A, &a)
+	assert.True(t, ge25519.UnpackVartime(&T, order8, false))
+	for i := 0; i < rt; i++ {
+		ge25519.Add(&R, &R, &T)
+	}
+	for i := 0; i < at; i++ {
+		ge25519.Add(&A, &A, &T)
+	}
+	ge25519.Pack(sig[:32], &R)
+	ge25519.Pack(pk[:], &A)
+	h := sha512.New()
+	h.Write(sig[:32])
+	h.Write(pk[:])
+	h.Write(msg)
+	modm.Expand(&k, h.Sum(nil))
+	modm.Mul(&s, &k, &a)
+	modm.Add(&s, &s, &r)
+	modm.Contract(sig[32:], &s)
+	return &pk, sig[:]
+}
+
+func TestVerifyBatchCofactored(t *testing.T) {
+	// only the cofactored equation holds if R has a small order component,
+	// it holds with A having one, the cofactorless one for k = 0 mod 8
+	keys, messages, signatures := batchInput(8)
+	accepted := 0
+	for i := 0; i < 32; i++ {
+		msg := []byte("small order component " + strconv.Itoa(i))
+		pk, sig := signWithTorsion(t, msg, i%8, i/8%4)
+		single, _ := pk.Verify(nil, sig, msg)
+		if single {
+			accepted++
+		}
+
+		assert.Equal(t, []bool{single}, VerifyBatch([]*EDDSAPublicKey{pk}, [][]byte{msg}, [][]byte{sig}), "i=%d", i)
+		// among valid signatures
+		j := i % len(keys)
+		keys[j], messages[j], signatures[j] = pk, msg, sig
+		result := VerifyBatch(keys, messages, signatures)
+		for n, valid := range result {
+			single, _ := keys[n].Verify(nil, signatures[n], messages[n])
+			assert.Equal(t, single, valid, "i=%d n=%d", i, n)
+		}
+	}
+	assert.NotZero(t, accepted)
+	assert.NotEqual(t, 32, accepted)
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	keys, messages, signatures := batchInput(64)
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			VerifyBatch(keys, messages, signatures)
+		}
+	})
+	b.Run("single", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range keys {
+				_, _ = keys[j].Verify(nil, signatures[j], messages[j])
+			}
+		}
+	})
+}
@@ -418,6 +418,72 @@ func DoubleScalarmultVartime(r, p1 *Ge25519, s1, s2 *modm.Bignum256) {
 	}
 }
 
+//MultiScalarmultVartime computes [s1[0]]p1[0] + ... + [s1[n-1]]p1[n-1] + [s2]basepoint,
+// the doublings are shared by all the points
+func MultiScalarmultVartime(r *Ge25519, p1 []Ge25519, s1 []modm.Bignum256, s2 *modm.Bignum256) {
+	var (
+		slide2 [256]int8
+		d1     Ge25519
+		t      ge25519p1p1
+		i      int
+		slide1 = make([][256]int8, len(p1))
+		pre1   = make([][s1TableSize]ge25519pniels, len(p1))
+	)
+
+	for j := range p1 {
+		modm.ContractSlidingWindow(&slide1[j], &s1[j], s1SWindowSize)
+		Double(&d1, &p1[j])
+		fullToPniels(&pre1[j][0], &p1[j])
+		for k := 0; k < s1TableSize-1; k++ {
+			pnielsAdd(&pre1[j][k+1], &d1, &pre1[j][k])
+		}
+	}
+	modm.ContractSlidingWindow(&slide2, s2, s2SWindowSize)
+
+	// set neutral
+	r.Zero()
+
+	nonzero := func(i int) bool {
+		if slide2[i] != 0 {
+			return true
+		}
+		for j := range slide1 {
+			if slide1[j][i] != 0 {
+				return true
+			}
+		}
+		return false
+	}
+	i = 255
+	for (i >= 0) && !nonzero(i) {
+		i--
+	}
+
+	abs := func(n int8) int {
+		if n < 0 {
+			return -int(n)
+		}
+		return int(n)
+	}
+	for ; i >= 0; i-- {
+		doubleP1p1(&t, r)
+
+		for j := range slide1 {
+			if slide1[j][i] != 0 {
+				p1p1ToFull(r, &t)
+				pnielsAddP1P1Vartime(&t, r, &pre1[j][abs(slide1[j][i])/2], uint8(slide1[j][i])>>7)
+			}
+		}
+
+		if slide2[i] != 0 {
+			p1p1ToFull(r, &t)
+			nielsAdd2P1p1Vartime(&t, r, &nielsSlidingMultiples[abs(slide2[i])/2], uint8(slide2[i])>>7)
+		}
+
+		p1p1ToPartial(r, &t)
+	}
+}
+
 //ScalarmultBaseNiels computes [s]basepoint
 func ScalarmultBaseNiels(r *Ge25519, s *modm.Bignum256) {
 	// ge25519_scalarmult_base_niels(ge25519 *r, const uint8_t basepoint_table[256][96], const bignum256modm s)
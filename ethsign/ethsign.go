@@ -0,0 +1,132 @@
+//Package ethsign hashes, signs and verifies Ethereum messages: personal messages of EIP-191
+// and typed structured data of EIP-712. Signatures are 65 bytes r || s || v with v 27 or 28
+// as returned by wallets, they are produced by asym.ECDSAPrivateKey in AlgoP256K1Recover mode.
+package ethsign
+
+import (
+	"crypto/rand"
+	"errors"
+	"strconv"
+
+	"github.com/meshplus/crypto-standard/asym"
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+// personalPrefix is the prefix of EIP-191 version 0x45 messages
+const personalPrefix = "\x19Ethereum Signed Message:\n"
+
+//error defines
+var (
+	ErrNotRecoverKey    = errors.New("ethsign: key is not in AlgoP256K1Recover mode")
+	ErrSignatureLength  = errors.New("ethsign: signature length is not 65")
+	ErrInvalidRecoverID = errors.New("ethsign: invalid signature recover id")
+	ErrAddressLength    = errors.New("ethsign: address length is not 20")
+)
+
+// keccak256 hashes the concatenation of data
+func keccak256(data ...[]byte) []byte {
+	ret, _ := hash.NewHasher(hash.KECCAK_256).BatchHash(data)
+	return ret
+}
+
+//HashPersonalMessage return the EIP-191 version 0x45 hash of msg, that is keccak256 of
+// "\x19Ethereum Signed Message:\n" || len(msg) || msg, as computed by personal_sign.
+func HashPersonalMessage(msg []byte) []byte {
+	return keccak256([]byte(personalPrefix), []byte(strconv.Itoa(len(msg))), msg)
+}
+
+//HashWithValidator return the EIP-191 version 0x00 hash of data intended for the contract at
+// validator, that is keccak256 of 0x19 || 0x00 || validator || data.
+func HashWithValidator(validator, data []byte) ([]byte, error) {
+	if len(validator) != 20 {
+		return nil, ErrAddressLength
+	}
+	return keccak256([]byte{0x19, 0x00}, validator, data), nil
+}
+
+//SignHash sign a 32 bytes digest with key and return r || s || v with v 27 or 28
+func SignHash(key *asym.ECDSAPrivateKey, digest []byte) ([]byte, error) {
+	if key.AlgorithmType() != asym.AlgoP256K1Recover {
+		return nil, ErrNotRecoverKey
+	}
+	sig, err := key.Sign(nil, digest, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+//SignPersonalMessage sign the EIP-191 hash of msg, see HashPersonalMessage
+func SignPersonalMessage(key *asym.ECDSAPrivateKey, msg []byte) ([]byte, error) {
+	return SignHash(key, HashPersonalMessage(msg))
+}
+
+//SignTypedData sign the EIP-712 hash of td, see TypedData.SigningHash
+func SignTypedData(key *asym.ECDSAPrivateKey, td *TypedData) ([]byte, error) {
+	digest, err := td.SigningHash()
+	if err != nil {
+		return nil, err
+	}
+	return SignHash(key, digest)
+}
+
+// normalize returns a copy of sig with v 0 or 1, both 0/1 and 27/28 are accepted
+func normalize(sig []byte) ([]byte, error) {
+	if len(sig) != 65 {
+		return nil, ErrSignatureLength
+	}
+	ret := append([]byte(nil), sig...)
+	if ret[64] >= 27 {
+		ret[64] -= 27
+	}
+	if ret[64] > 1 {
+		return nil, ErrInvalidRecoverID
+	}
+	return ret, nil
+}
+
+//RecoverAddress return the 20 bytes address of the key that signed digest
+func RecoverAddress(digest, sig []byte) ([]byte, error) {
+	sig, err := normalize(sig)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := secp256k1.RecoverPubkey(digest, sig)
+	if err != nil {
+		return nil, err
+	}
+	//remove 04
+	return keccak256(pub[1:])[12:], nil
+}
+
+//VerifyHash verify that sig of digest was produced by the key of address
+func VerifyHash(address, digest, sig []byte) (valid bool, err error) {
+	if len(address) != 20 {
+		return false, ErrAddressLength
+	}
+	sig, err = normalize(sig)
+	if err != nil {
+		return false, err
+	}
+	key := new(asym.ECDSAPublicKey)
+	if err = key.FromBytes(address, asym.AlgoP256K1Recover); err != nil {
+		return false, err
+	}
+	return key.Verify(nil, sig, digest)
+}
+
+//VerifyPersonalMessage verify a signature of SignPersonalMessage
+func VerifyPersonalMessage(address, msg, sig []byte) (valid bool, err error) {
+	return VerifyHash(address, HashPersonalMessage(msg), sig)
+}
+
+//VerifyTypedData verify a signature of SignTypedData
+func VerifyTypedData(address []byte, td *TypedData, sig []byte) (valid bool, err error) {
+	digest, err := td.SigningHash()
+	if err != nil {
+		return false, err
+	}
+	return VerifyHash(address, digest, sig)
+}
@@ -0,0 +1,199 @@
+package ethsign
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/asym"
+	"github.com/stretchr/testify/assert"
+)
+
+// the example of EIP-712
+const mailJSON = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallet", "type": "address"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person"},
+      {"name": "contents", "type": "string"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": 1,
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+    "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+    "contents": "Hello, Bob!"
+  }
+}`
+
+const (
+	// keccak256("cow")
+	cowKey     = "c85ef7d79691fe79573b1a7064c19c1a9819ebdbd1faaab1a8ec92344438aaf4"
+	cowAddress = "cd2a3d9f938e13cd947ec05abc7fe734df8dd826"
+)
+
+func cow(t *testing.T) (*asym.ECDSAPrivateKey, []byte) {
+	k, _ := hex.DecodeString(cowKey)
+	key := new(asym.ECDSAPrivateKey)
+	assert.Nil(t, key.FromBytes(k, asym.AlgoP256K1Recover))
+	addr, _ := hex.DecodeString(cowAddress)
+	return key, addr
+}
+
+func TestTypedData(t *testing.T) {
+	td, err := ParseTypedData([]byte(mailJSON))
+	assert.Nil(t, err)
+
+	enc, err := td.EncodeType("Mail")
+	assert.Nil(t, err)
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", enc)
+	typeHash, err := td.TypeHash("Mail")
+	assert.Nil(t, err)
+	assert.Equal(t, "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", hex.EncodeToString(typeHash))
+
+	domain, err := td.DomainSeparator()
+	assert.Nil(t, err)
+	assert.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domain))
+	msg, err := td.HashStruct("Mail", td.Message)
+	assert.Nil(t, err)
+	assert.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(msg))
+	digest, err := td.SigningHash()
+	assert.Nil(t, err)
+	assert.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(digest))
+
+	// EIP712Domain is derived from the domain if it is not declared
+	delete(td.Types, domainType)
+	derived, err := td.DomainSeparator()
+	assert.Nil(t, err)
+	assert.Equal(t, domain, derived)
+
+	key, addr := cow(t)
+	sig, err := SignTypedData(key, td)
+	assert.Nil(t, err)
+	recovered, err := RecoverAddress(digest, sig)
+	assert.Nil(t, err)
+	assert.Equal(t, addr, recovered)
+	valid, err := VerifyTypedData(addr, td, sig)
+	assert.Nil(t, err)
+	assert.True(t, valid)
+
+	td.Message["contents"] = "Hello, Alice!"
+	valid, err = VerifyTypedData(addr, td, sig)
+	assert.NotNil(t, err)
+	assert.False(t, valid)
+}
+
+func TestEncodeValue(t *testing.T) {
+	td := &TypedData{
+		Types: map[string][]Field{
+			"Group": {{Name: "name", Type: "string"}, {Name: "members", Type: "Person[]"}},
+			"Person": {{Name: "name", Type: "string"}, {Name: "wallets", Type: "address[]"}},
+		},
+	}
+	enc, err := td.EncodeType("Group")
+	assert.Nil(t, err)
+	assert.Equal(t, "Group(string name,Person[] members)Person(string name,address[] wallets)", enc)
+
+	cases := []struct {
+		typ    string
+		value  interface{}
+		expect string
+	}{
+		{"uint8", "255", "00000000000000000000000000000000000000000000000000000000000000ff"},
+		{"uint256", "0x0100", "0000000000000000000000000000000000000000000000000000000000000100"},
+		{"int8", -1, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{"int", "-128", "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80"},
+		{"bool", true, "0000000000000000000000000000000000000000000000000000000000000001"},
+		{"bytes4", "0x01020304", "0102030400000000000000000000000000000000000000000000000000000000"},
+		// keccak256 of the empty string
+		{"bytes", "0x", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"string", "", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"uint8[0]", []interface{}{}, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+	}
+	for _, c := range cases {
+		enc, err := td.encodeValue(c.typ, c.value)
+		assert.Nil(t, err, c.typ)
+		assert.Equal(t, c.expect, hex.EncodeToString(enc), c.typ)
+	}
+
+	invalid := []struct {
+		typ   string
+		value interface{}
+	}{
+		{"uint8", "256"},
+		{"uint8", -1},
+		{"int8", 128},
+		{"uint7", 1},
+		{"bytes4", "0x010203"},
+		{"bytes33", "0x01"},
+		{"address", "0x01"},
+		{"address", "cd2a3d9f938e13cd947ec05abc7fe734df8dd826"},
+		{"bool", "true"},
+		{"uint8[2]", []interface{}{1}},
+		{"Person", "Bob"},
+		{"Unknown", 1},
+	}
+	for _, c := range invalid {
+		_, err := td.encodeValue(c.typ, c.value)
+		assert.NotNil(t, err, c.typ)
+	}
+
+	_, err = td.HashStruct("Person", map[string]interface{}{"name": "Bob"})
+	assert.NotNil(t, err)
+	_, err = ParseTypedData([]byte(`{"types": {}}`))
+	assert.NotNil(t, err)
+}
+
+func TestPersonalMessage(t *testing.T) {
+	assert.Equal(t, "a1de988600a42c4b4ab089b619297c17d53cffae5d5120d82d8a92d0bb3b78f2",
+		hex.EncodeToString(HashPersonalMessage([]byte("Hello World"))))
+
+	key, addr := cow(t)
+	sig, err := SignPersonalMessage(key, []byte("Hello World"))
+	assert.Nil(t, err)
+	assert.Len(t, sig, 65)
+	assert.True(t, sig[64] == 27 || sig[64] == 28)
+	valid, err := VerifyPersonalMessage(addr, []byte("Hello World"), sig)
+	assert.Nil(t, err)
+	assert.True(t, valid)
+
+	// v of 0 or 1 is accepted as well
+	sig[64] -= 27
+	valid, err = VerifyPersonalMessage(addr, []byte("Hello World"), sig)
+	assert.Nil(t, err)
+	assert.True(t, valid)
+	valid, _ = VerifyPersonalMessage(addr, []byte("Hello World!"), sig)
+	assert.False(t, valid)
+
+	sig[64] = 29
+	_, err = VerifyPersonalMessage(addr, []byte("Hello World"), sig)
+	assert.Equal(t, ErrInvalidRecoverID, err)
+	_, err = VerifyPersonalMessage(addr, []byte("Hello World"), sig[:64])
+	assert.Equal(t, ErrSignatureLength, err)
+
+	k1, err := asym.GenerateKey(asym.AlgoP256K1)
+	assert.Nil(t, err)
+	_, err = SignPersonalMessage(k1, []byte("Hello World"))
+	assert.Equal(t, ErrNotRecoverKey, err)
+
+	digest, err := HashWithValidator(addr, []byte("data"))
+	assert.Nil(t, err)
+	assert.Len(t, digest, 32)
+	_, err = HashWithValidator(addr[:19], nil)
+	assert.Equal(t, ErrAddressLength, err)
+}
@@ -0,0 +1,363 @@
+package ethsign
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// domainType is the name of the type of the EIP-712 domain
+const domainType = "EIP712Domain"
+
+// domainFields are the fields of EIP712Domain in their canonical order, the ones
+// present in the domain are used if the types do not declare EIP712Domain.
+var domainFields = []Field{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+	{Name: "salt", Type: "bytes32"},
+}
+
+var (
+	intType   = regexp.MustCompile(`^(u?)int([0-9]*)$`)
+	bytesType = regexp.MustCompile(`^bytes([0-9]+)$`)
+	arrayType = regexp.MustCompile(`^(.+)\[([0-9]*)\]$`)
+)
+
+//Field is a member of a struct type
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+//TypedData is the typed structured data of EIP-712, the JSON form is the one of eth_signTypedData_v4.
+// Values are JSON values: integers may be numbers or decimal or 0x-prefixed strings, addresses and
+// bytes are 0x-prefixed hex strings, structs are objects and arrays are arrays.
+type TypedData struct {
+	Types       map[string][]Field     `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      map[string]interface{} `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+//ParseTypedData parse the JSON description of typed data, numbers are kept exact
+func ParseTypedData(data []byte) (*TypedData, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	td := new(TypedData)
+	if err := dec.Decode(td); err != nil {
+		return nil, fmt.Errorf("ethsign: invalid typed data: %v", err)
+	}
+	if td.PrimaryType == "" {
+		return nil, fmt.Errorf("ethsign: primary type is missing")
+	}
+	return td, nil
+}
+
+// fields returns the members of the struct type name
+func (td *TypedData) fields(name string) ([]Field, bool) {
+	if f, ok := td.Types[name]; ok {
+		return f, true
+	}
+	if name != domainType {
+		return nil, false
+	}
+	var ret []Field
+	for _, f := range domainFields {
+		if _, ok := td.Domain[f.Name]; ok {
+			ret = append(ret, f)
+		}
+	}
+	return ret, true
+}
+
+// baseType strips the array dimensions of typ, "Person[][2]" becomes "Person"
+func baseType(typ string) string {
+	for {
+		m := arrayType.FindStringSubmatch(typ)
+		if m == nil {
+			return typ
+		}
+		typ = m[1]
+	}
+}
+
+// dependencies adds the struct types name refers to, name included, to deps
+func (td *TypedData) dependencies(name string, deps map[string]bool) {
+	if deps[name] {
+		return
+	}
+	fields, ok := td.fields(name)
+	if !ok {
+		return
+	}
+	deps[name] = true
+	for _, f := range fields {
+		td.dependencies(baseType(f.Type), deps)
+	}
+}
+
+//EncodeType return encodeType of the struct type name, e.g.
+// "Mail(Person from,Person to,string contents)Person(string name,address wallet)"
+func (td *TypedData) EncodeType(name string) (string, error) {
+	if _, ok := td.fields(name); !ok {
+		return "", fmt.Errorf("ethsign: unknown type %q", name)
+	}
+	deps := make(map[string]bool)
+	td.dependencies(name, deps)
+	delete(deps, name)
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	for _, typ := range append([]string{name}, sorted...) {
+		fields, _ := td.fields(typ)
+		b.WriteString(typ)
+		b.WriteByte('(')
+		for i, f := range fields {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(f.Type)
+			b.WriteByte(' ')
+			b.WriteString(f.Name)
+		}
+		b.WriteByte(')')
+	}
+	return b.String(), nil
+}
+
+//TypeHash return keccak256 of EncodeType(name)
+func (td *TypedData) TypeHash(name string) ([]byte, error) {
+	enc, err := td.EncodeType(name)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256([]byte(enc)), nil
+}
+
+//HashStruct return hashStruct of data as a value of the struct type name
+func (td *TypedData) HashStruct(name string, data map[string]interface{}) ([]byte, error) {
+	enc, err := td.EncodeData(name, data)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(enc), nil
+}
+
+//EncodeData return typeHash || encodeData of data as a value of the struct type name,
+// every field of the type must be present.
+func (td *TypedData) EncodeData(name string, data map[string]interface{}) ([]byte, error) {
+	typeHash, err := td.TypeHash(name)
+	if err != nil {
+		return nil, err
+	}
+	fields, _ := td.fields(name)
+	ret := make([]byte, 0, 32*(len(fields)+1))
+	ret = append(ret, typeHash...)
+	for _, f := range fields {
+		v, ok := data[f.Name]
+		if !ok {
+			return nil, fmt.Errorf("ethsign: field %s of %s is missing", f.Name, name)
+		}
+		enc, err := td.encodeValue(f.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("ethsign: field %s of %s: %v", f.Name, name, err)
+		}
+		ret = append(ret, enc...)
+	}
+	return ret, nil
+}
+
+//DomainSeparator return hashStruct(domain)
+func (td *TypedData) DomainSeparator() ([]byte, error) {
+	return td.HashStruct(domainType, td.Domain)
+}
+
+//SigningHash return keccak256(0x19 || 0x01 || domainSeparator || hashStruct(message)),
+// the digest signed by eth_signTypedData_v4.
+func (td *TypedData) SigningHash() ([]byte, error) {
+	domain, err := td.DomainSeparator()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256([]byte{0x19, 0x01}, domain, msg), nil
+}
+
+// encodeValue returns the 32 bytes encoding of v as a value of typ
+func (td *TypedData) encodeValue(typ string, v interface{}) ([]byte, error) {
+	if m := arrayType.FindStringSubmatch(typ); m != nil {
+		items, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s is not an array", typ)
+		}
+		if m[2] != "" {
+			if n, _ := strconv.Atoi(m[2]); n != len(items) {
+				return nil, fmt.Errorf("%s has %d elements", typ, len(items))
+			}
+		}
+		enc := make([]byte, 0, 32*len(items))
+		for _, item := range items {
+			e, err := td.encodeValue(m[1], item)
+			if err != nil {
+				return nil, err
+			}
+			enc = append(enc, e...)
+		}
+		return keccak256(enc), nil
+	}
+	if _, ok := td.fields(typ); ok {
+		data, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s is not an object", typ)
+		}
+		return td.HashStruct(typ, data)
+	}
+	return encodeAtomic(typ, v)
+}
+
+// encodeAtomic encodes the value of a type that is not a struct or an array
+func encodeAtomic(typ string, v interface{}) ([]byte, error) {
+	ret := make([]byte, 32)
+	switch typ {
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a string", v)
+		}
+		return keccak256([]byte(s)), nil
+	case "bytes":
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(b), nil
+	case "bool":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a bool", v)
+		}
+		if b {
+			ret[31] = 1
+		}
+		return ret, nil
+	case "address":
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != 20 {
+			return nil, ErrAddressLength
+		}
+		copy(ret[12:], b)
+		return ret, nil
+	}
+	if m := bytesType.FindStringSubmatch(typ); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if n < 1 || n > 32 {
+			return nil, fmt.Errorf("invalid type %s", typ)
+		}
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != n {
+			return nil, fmt.Errorf("%s has %d bytes", typ, len(b))
+		}
+		copy(ret, b)
+		return ret, nil
+	}
+	if m := intType.FindStringSubmatch(typ); m != nil {
+		bits := 256
+		if m[2] != "" {
+			bits, _ = strconv.Atoi(m[2])
+		}
+		if bits < 8 || bits > 256 || bits%8 != 0 {
+			return nil, fmt.Errorf("invalid type %s", typ)
+		}
+		x, err := toBig(v)
+		if err != nil {
+			return nil, err
+		}
+		min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+		if m[1] == "" {
+			max.Rsh(max, 1)
+			min.Neg(max)
+		}
+		if x.Cmp(min) < 0 || x.Cmp(max) >= 0 {
+			return nil, fmt.Errorf("%v overflows %s", x, typ)
+		}
+		// two's complement in 256 bits
+		if x.Sign() < 0 {
+			x.Add(x, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		x.FillBytes(ret)
+		return ret, nil
+	}
+	return nil, fmt.Errorf("unknown type %s", typ)
+}
+
+// toBytes decodes a 0x-prefixed hex string, byte slices are returned as is
+func toBytes(v interface{}) ([]byte, error) {
+	switch b := v.(type) {
+	case []byte:
+		return b, nil
+	case string:
+		if !strings.HasPrefix(b, "0x") && !strings.HasPrefix(b, "0X") {
+			return nil, fmt.Errorf("%q is not 0x-prefixed hex", b)
+		}
+		return hex.DecodeString(b[2:])
+	default:
+		return nil, fmt.Errorf("%v is not hex bytes", v)
+	}
+}
+
+// toBig converts a JSON number, a decimal or 0x-prefixed hex string or a Go integer to a new big.Int
+func toBig(v interface{}) (*big.Int, error) {
+	var s string
+	switch x := v.(type) {
+	case json.Number:
+		s = x.String()
+	case string:
+		s = x
+	case *big.Int:
+		return new(big.Int).Set(x), nil
+	case int:
+		return big.NewInt(int64(x)), nil
+	case int64:
+		return big.NewInt(x), nil
+	case uint64:
+		return new(big.Int).SetUint64(x), nil
+	case float64:
+		if f := new(big.Float).SetFloat64(x); f.IsInt() {
+			ret, _ := f.Int(nil)
+			return ret, nil
+		}
+		return nil, fmt.Errorf("%v is not an integer", x)
+	default:
+		return nil, fmt.Errorf("%v is not an integer", v)
+	}
+	base := 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s, base = s[2:], 16
+	}
+	ret, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return nil, fmt.Errorf("%q is not an integer", s)
+	}
+	return ret, nil
+}
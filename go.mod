@@ -3,6 +3,8 @@ module github.com/meshplus/crypto-standard
 require (
 	github.com/meshplus/crypto v0.0.8
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
+	golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1
 )
 
 go 1.15
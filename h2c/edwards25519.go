@@ -0,0 +1,186 @@
+package h2c
+
+import (
+	"crypto/subtle"
+	"math/big"
+
+	"github.com/meshplus/crypto-standard/ed25519/curve25519"
+	"github.com/meshplus/crypto-standard/ed25519/ge25519"
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+// constants of the Elligator 2 map of RFC 9380 appendix G.2
+var (
+	p25519 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	feOne  = curve25519.Bignum25519{1}
+	// J = 486662, the Montgomery A of curve25519
+	feJ = curve25519.Bignum25519{486662}
+	// c2 = 2^((q + 3) / 8)
+	feC2 = bigToFe(new(big.Int).Exp(big.NewInt(2), new(big.Int).Rsh(new(big.Int).Add(p25519, big.NewInt(3)), 3), p25519))
+	// c3 = sqrt(-1)
+	feSqrtM1 = bigToFe(new(big.Int).Exp(big.NewInt(2), new(big.Int).Rsh(new(big.Int).Sub(p25519, big.NewInt(1)), 2), p25519))
+	// sqrt(-486664) with sgn0 = 0, scales the rational map to edwards25519
+	feSqrtM486664 = func() curve25519.Bignum25519 {
+		r := new(big.Int).ModSqrt(new(big.Int).Sub(p25519, big.NewInt(486664)), p25519)
+		if r.Bit(0) == 1 {
+			r.Sub(p25519, r)
+		}
+		return bigToFe(r)
+	}()
+)
+
+// bigToFe converts x in [0, p) to a field element
+func bigToFe(x *big.Int) (fe curve25519.Bignum25519) {
+	var b [32]byte
+	x.FillBytes(b[:])
+	for i := 0; i < 16; i++ {
+		b[i], b[31-i] = b[31-i], b[i]
+	}
+	curve25519.Expand(&fe, b[:])
+	return fe
+}
+
+// feEqual returns 1 if a == b and 0 otherwise
+func feEqual(a, b *curve25519.Bignum25519) uint64 {
+	var ab, bb [32]byte
+	curve25519.Contract(ab[:], a)
+	curve25519.Contract(bb[:], b)
+	return uint64(subtle.ConstantTimeCompare(ab[:], bb[:]))
+}
+
+// feSgn0 returns the parity of the canonical encoding of a
+func feSgn0(a *curve25519.Bignum25519) uint64 {
+	var b [32]byte
+	curve25519.Contract(b[:], a)
+	return uint64(b[0] & 1)
+}
+
+// feCmov sets out to a if c is 1, out is unchanged if c is 0
+func feCmov(out, a *curve25519.Bignum25519, c uint64) {
+	t := *a
+	curve25519.SwapConditional(out, &t, c)
+}
+
+// elligator2Curve25519 implements map_to_curve_elligator2_curve25519 of RFC 9380 appendix G.2.1,
+// it returns the Montgomery point (xn / xd, y).
+func elligator2Curve25519(u *curve25519.Bignum25519) (xn, xd, y curve25519.Bignum25519) {
+	var tv1, tv2, tv3, x1n, x2n, gxd, gx1, gx2, y1, y11, y12, y2, y21, y22, negY curve25519.Bignum25519
+
+	curve25519.Square(&tv1, u)
+	curve25519.AddReduce(&tv1, &tv1, &tv1)
+	curve25519.AddReduce(&xd, &tv1, &feOne)
+	curve25519.Neg(&x1n, &feJ)
+	curve25519.Square(&tv2, &xd)
+	curve25519.Mul(&gxd, &tv2, &xd)
+	curve25519.Mul(&gx1, &feJ, &tv1)
+	curve25519.Mul(&gx1, &gx1, &x1n)
+	curve25519.AddReduce(&gx1, &gx1, &tv2)
+	curve25519.Mul(&gx1, &gx1, &x1n)
+	curve25519.Square(&tv3, &gxd)
+	curve25519.Square(&tv2, &tv3)
+	curve25519.Mul(&tv3, &tv3, &gxd)
+	curve25519.Mul(&tv3, &tv3, &gx1)
+	curve25519.Mul(&tv2, &tv2, &tv3)
+	curve25519.PowTwo252m3(&y11, &tv2)
+	curve25519.Mul(&y11, &y11, &tv3)
+	curve25519.Mul(&y12, &y11, &feSqrtM1)
+	curve25519.Square(&tv2, &y11)
+	curve25519.Mul(&tv2, &tv2, &gxd)
+	y1 = y12
+	feCmov(&y1, &y11, feEqual(&tv2, &gx1))
+
+	curve25519.Mul(&x2n, &x1n, &tv1)
+	curve25519.Mul(&y21, &y11, u)
+	curve25519.Mul(&y21, &y21, &feC2)
+	curve25519.Mul(&y22, &y21, &feSqrtM1)
+	curve25519.Mul(&gx2, &gx1, &tv1)
+	curve25519.Square(&tv2, &y21)
+	curve25519.Mul(&tv2, &tv2, &gxd)
+	y2 = y22
+	feCmov(&y2, &y21, feEqual(&tv2, &gx2))
+
+	curve25519.Square(&tv2, &y1)
+	curve25519.Mul(&tv2, &tv2, &gxd)
+	e3 := feEqual(&tv2, &gx1)
+	xn = x2n
+	feCmov(&xn, &x1n, e3)
+	y = y2
+	feCmov(&y, &y1, e3)
+	curve25519.Neg(&negY, &y)
+	feCmov(&y, &negY, e3^feSgn0(&y))
+	return xn, xd, y
+}
+
+// elligator2Edwards25519 implements map_to_curve_elligator2_edwards25519 of RFC 9380 appendix G.2.2
+func elligator2Edwards25519(u *curve25519.Bignum25519, r *ge25519.Ge25519) {
+	var xn, xd, yn, yd, tv1, zero curve25519.Bignum25519
+
+	xMn, xMd, yMn := elligator2Curve25519(u)
+	// yMd is 1
+	curve25519.Mul(&xn, &xMn, &feSqrtM486664)
+	curve25519.Mul(&xd, &xMd, &yMn)
+	curve25519.SubReduce(&yn, &xMn, &xMd)
+	curve25519.AddReduce(&yd, &xMn, &xMd)
+	curve25519.Mul(&tv1, &xd, &yd)
+	e := feEqual(&tv1, &zero)
+	feCmov(&xn, &zero, e)
+	feCmov(&xd, &feOne, e)
+	feCmov(&yn, &feOne, e)
+	feCmov(&yd, &feOne, e)
+
+	// extended coordinates of (xn / xd, yn / yd)
+	curve25519.Mul(&r.X, &xn, &yd)
+	curve25519.Mul(&r.Y, &yn, &xd)
+	curve25519.Mul(&r.Z, &xd, &yd)
+	curve25519.Mul(&r.T, &xn, &yn)
+}
+
+// edwards25519Field hashes msg to count elements of GF(2^255 - 19)
+func edwards25519Field(msg, dst []byte, count int) ([]curve25519.Bignum25519, error) {
+	u, err := hashToField(hash.SHA2_512, msg, dst, p25519, 48, count)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]curve25519.Bignum25519, count)
+	for i := range u {
+		ret[i] = bigToFe(u[i])
+	}
+	return ret, nil
+}
+
+// clearCofactor multiplies r by the cofactor 8
+func clearCofactor(r *ge25519.Ge25519) {
+	ge25519.Double(r, r)
+	ge25519.Double(r, r)
+	ge25519.Double(r, r)
+}
+
+//HashToEdwards25519 hash msg to a point of edwards25519 with the suite
+// edwards25519_XMD:SHA-512_ELL2_RO_, the point is in the prime order subgroup.
+// dst is the domain separation tag of the application and must not be empty.
+func HashToEdwards25519(msg, dst []byte) (*ge25519.Ge25519, error) {
+	u, err := edwards25519Field(msg, dst, 2)
+	if err != nil {
+		return nil, err
+	}
+	var q0, q1 ge25519.Ge25519
+	elligator2Edwards25519(&u[0], &q0)
+	elligator2Edwards25519(&u[1], &q1)
+	r := new(ge25519.Ge25519)
+	ge25519.Add(r, &q0, &q1)
+	clearCofactor(r)
+	return r, nil
+}
+
+//EncodeToEdwards25519 encode msg to a point of edwards25519 with the suite
+// edwards25519_XMD:SHA-512_ELL2_NU_, the output is not uniformly distributed.
+func EncodeToEdwards25519(msg, dst []byte) (*ge25519.Ge25519, error) {
+	u, err := edwards25519Field(msg, dst, 1)
+	if err != nil {
+		return nil, err
+	}
+	r := new(ge25519.Ge25519)
+	elligator2Edwards25519(&u[0], r)
+	clearCofactor(r)
+	return r, nil
+}
@@ -0,0 +1,42 @@
+// Package h2c implements hashing to elliptic curves as specified in RFC 9380
+// for the suites
+//
+// P256_XMD:SHA-256_SSWU_RO_ and P256_XMD:SHA-256_SSWU_NU_,
+// secp256k1_XMD:SHA-256_SSWU_RO_ and secp256k1_XMD:SHA-256_SSWU_NU_,
+// edwards25519_XMD:SHA-512_ELL2_RO_ and edwards25519_XMD:SHA-512_ELL2_NU_.
+//
+// The HashTo functions are random oracles and should be used unless the
+// protocol only needs a deterministic encoding, in which case the cheaper
+// EncodeTo functions can be used. The point is a deterministic function of
+// msg and dst, and nobody knows its discrete logarithm.
+package h2c
+
+import (
+	"math/big"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+// hashToField implements hash_to_field of RFC 9380 section 5.2 for prime fields:
+// count elements of GF(p), each reduced from l uniform bytes.
+func hashToField(hashType hash.HashType, msg, dst []byte, p *big.Int, l, count int) ([]*big.Int, error) {
+	uniform, err := hash.ExpandMessageXMD(hashType, msg, dst, l*count)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*big.Int, count)
+	for i := range ret {
+		ret[i] = new(big.Int).SetBytes(uniform[i*l : (i+1)*l])
+		ret[i].Mod(ret[i], p)
+	}
+	return ret, nil
+}
+
+// fromHex parses a constant
+func fromHex(s string) *big.Int {
+	r, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("h2c: invalid constant " + s)
+	}
+	return r
+}
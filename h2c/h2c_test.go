@@ -0,0 +1,139 @@
+package h2c
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+	"github.com/meshplus/crypto-standard/ed25519/ge25519"
+	"github.com/stretchr/testify/assert"
+)
+
+// messages of the test vectors in RFC 9380 appendix J
+var msgs = []string{"", "abc", "abcdef0123456789", "q128_" + strings.Repeat("q", 128), "a512_" + strings.Repeat("a", 512)}
+
+// suiteVector is the dst and the expected affine points of msgs
+type suiteVector struct {
+	dst    string
+	points [][2]string
+}
+
+var hashToP256Vector = suiteVector{
+	dst: "QUUX-V01-CS02-with-P256_XMD:SHA-256_SSWU_RO_",
+	points: [][2]string{
+		{"2c15230b26dbc6fc9a37051158c95b79656e17a1a920b11394ca91c44247d3e4", "8a7a74985cc5c776cdfe4b1f19884970453912e9d31528c060be9ab5c43e8415"},
+		{"0bb8b87485551aa43ed54f009230450b492fead5f1cc91658775dac4a3388a0f", "5c41b3d0731a27a7b14bc0bf0ccded2d8751f83493404c84a88e71ffd424212e"},
+		{"65038ac8f2b1def042a5df0b33b1f4eca6bff7cb0f9c6c1526811864e544ed80", "cad44d40a656e7aff4002a8de287abc8ae0482b5ae825822bb870d6df9b56ca3"},
+		{"4be61ee205094282ba8a2042bcb48d88dfbb609301c49aa8b078533dc65a0b5d", "98f8df449a072c4721d241a3b1236d3caccba603f916ca680f4539d2bfb3c29e"},
+		{"457ae2981f70ca85d8e24c308b14db22f3e3862c5ea0f652ca38b5e49cd64bc5", "ecb9f0eadc9aeed232dabc53235368c1394c78de05dd96893eefa62b0f4757dc"},
+	},
+}
+
+var encodeToP256Vector = suiteVector{
+	dst: "QUUX-V01-CS02-with-P256_XMD:SHA-256_SSWU_NU_",
+	points: [][2]string{
+		{"f871caad25ea3b59c16cf87c1894902f7e7b2c822c3d3f73596c5ace8ddd14d1", "87b9ae23335bee057b99bac1e68588b18b5691af476234b8971bc4f011ddc99b"},
+		{"fc3f5d734e8dce41ddac49f47dd2b8a57257522a865c124ed02b92b5237befa4", "fe4d197ecf5a62645b9690599e1d80e82c500b22ac705a0b421fac7b47157866"},
+		{"f164c6674a02207e414c257ce759d35eddc7f55be6d7f415e2cc177e5d8faa84", "3aa274881d30db70485368c0467e97da0e73c18c1d00f34775d012b6fcee7f97"},
+		{"324532006312be4f162614076460315f7a54a6f85544da773dc659aca0311853", "8d8197374bcd52de2acfefc8a54fe2c8d8bebd2a39f16be9b710e4b1af6ef883"},
+		{"5c4bad52f81f39c8e8de1260e9a06d72b8b00a0829a8ea004a610b0691bea5d9", "c801e7c0782af1f74f24fc385a8555da0582032a3ce038de637ccdcb16f7ef7b"},
+	},
+}
+
+var hashToSecp256k1Vector = suiteVector{
+	dst: "QUUX-V01-CS02-with-secp256k1_XMD:SHA-256_SSWU_RO_",
+	points: [][2]string{
+		{"c1cae290e291aee617ebaef1be6d73861479c48b841eaba9b7b5852ddfeb1346", "64fa678e07ae116126f08b022a94af6de15985c996c3a91b64c406a960e51067"},
+		{"3377e01eab42db296b512293120c6cee72b6ecf9f9205760bd9ff11fb3cb2c4b", "7f95890f33efebd1044d382a01b1bee0900fb6116f94688d487c6c7b9c8371f6"},
+		{"bac54083f293f1fe08e4a70137260aa90783a5cb84d3f35848b324d0674b0e3a", "4436476085d4c3c4508b60fcf4389c40176adce756b398bdee27bca19758d828"},
+		{"e2167bc785333a37aa562f021f1e881defb853839babf52a7f72b102e41890e9", "f2401dd95cc35867ffed4f367cd564763719fbc6a53e969fb8496a1e6685d873"},
+		{"e3c8d35aaaf0b9b647e88a0a0a7ee5d5bed5ad38238152e4e6fd8c1f8cb7c998", "8446eeb6181bf12f56a9d24e262221cc2f0c4725c7e3803024b5888ee5823aa6"},
+	},
+}
+
+var encodeToSecp256k1Vector = suiteVector{
+	dst: "QUUX-V01-CS02-with-secp256k1_XMD:SHA-256_SSWU_NU_",
+	points: [][2]string{
+		{"a4792346075feae77ac3b30026f99c1441b4ecf666ded19b7522cf65c4c55c5b", "62c59e2a6aeed1b23be5883e833912b08ba06be7f57c0e9cdc663f31639ff3a7"},
+		{"3f3b5842033fff837d504bb4ce2a372bfeadbdbd84a1d2b678b6e1d7ee426b9d", "902910d1fef15d8ae2006fc84f2a5a7bda0e0407dc913062c3a493c4f5d876a5"},
+		{"07644fa6281c694709f53bdd21bed94dab995671e4a8cd1904ec4aa50c59bfdf", "c79f8d1dad79b6540426922f7fbc9579c3018dafeffcd4552b1626b506c21e7b"},
+		{"b734f05e9b9709ab631d960fa26d669c4aeaea64ae62004b9d34f483aa9acc33", "03fc8a4a5a78632e2eb4d8460d69ff33c1d72574b79a35e402e801f2d0b1d6ee"},
+		{"17d22b867658977b5002dbe8d0ee70a8cfddec3eec50fb93f36136070fd9fa6c", "e9178ff02f4dab73480f8dd590328aea99856a7b6cc8e5a6cdf289ecc2a51718"},
+	},
+}
+
+var hashToEdwards25519Vector = suiteVector{
+	dst: "QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_RO_",
+	points: [][2]string{
+		{"3c3da6925a3c3c268448dcabb47ccde5439559d9599646a8260e47b1e4822fc6", "09a6c8561a0b22bef63124c588ce4c62ea83a3c899763af26d795302e115dc21"},
+		{"608040b42285cc0d72cbb3985c6b04c935370c7361f4b7fbdb1ae7f8c1a8ecad", "1a8395b88338f22e435bbd301183e7f20a5f9de643f11882fb237f88268a5531"},
+		{"6d7fabf47a2dc03fe7d47f7dddd21082c5fb8f86743cd020f3fb147d57161472", "53060a3d140e7fbcda641ed3cf42c88a75411e648a1add71217f70ea8ec561a6"},
+		{"5fb0b92acedd16f3bcb0ef83f5c7b7a9466b5f1e0d8d217421878ea3686f8524", "2eca15e355fcfa39d2982f67ddb0eea138e2994f5956ed37b7f72eea5e89d2f7"},
+		{"0efcfde5898a839b00997fbe40d2ebe950bc81181afbd5cd6b9618aa336c1e8c", "6dc2fc04f266c5c27f236a80b14f92ccd051ef1ff027f26a07f8c0f327d8f995"},
+	},
+}
+
+var encodeToEdwards25519Vector = suiteVector{
+	dst: "QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_NU_",
+	points: [][2]string{
+		{"1ff2b70ecf862799e11b7ae744e3489aa058ce805dd323a936375a84695e76da", "222e314d04a4d5725e9f2aff9fb2a6b69ef375a1214eb19021ceab2d687f0f9b"},
+		{"5f13cc69c891d86927eb37bd4afc6672360007c63f68a33ab423a3aa040fd2a8", "67732d50f9a26f73111dd1ed5dba225614e538599db58ba30aaea1f5c827fa42"},
+		{"1dd2fefce934ecfd7aae6ec998de088d7dd03316aa1847198aecf699ba6613f1", "2f8a6c24dd1adde73909cada6a4a137577b0f179d336685c4a955a0a8e1a86fb"},
+		{"35fbdc5143e8a97afd3096f2b843e07df72e15bfca2eaf6879bf97c5d3362f73", "2af6ff6ef5ebba128b0774f4296cb4c2279a074658b083b8dcca91f57a603450"},
+		{"6e5e1f37e99345887fc12111575fc1c3e36df4b289b8759d23af14d774b66bff", "2c90c3d39eb18ff291d33441b35f3262cdd307162cc97c31bfcc7a4245891a37"},
+	},
+}
+
+func fromVector(s string) *big.Int {
+	r, _ := new(big.Int).SetString(s, 16)
+	return r
+}
+
+func testWeierstrass(t *testing.T, f func(msg, dst []byte) (*big.Int, *big.Int, error), v suiteVector) {
+	for i, msg := range msgs {
+		x, y, err := f([]byte(msg), []byte(v.dst))
+		assert.Nil(t, err)
+		assert.Equal(t, fromVector(v.points[i][0]), x, msg)
+		assert.Equal(t, fromVector(v.points[i][1]), y, msg)
+	}
+	_, _, err := f([]byte("abc"), nil)
+	assert.NotNil(t, err)
+}
+
+func TestP256(t *testing.T) {
+	testWeierstrass(t, HashToP256, hashToP256Vector)
+	testWeierstrass(t, EncodeToP256, encodeToP256Vector)
+}
+
+func TestSecp256k1(t *testing.T) {
+	testWeierstrass(t, HashToSecp256k1, hashToSecp256k1Vector)
+	testWeierstrass(t, EncodeToSecp256k1, encodeToSecp256k1Vector)
+	x, y, _ := HashToSecp256k1([]byte("abc"), []byte(hashToSecp256k1Vector.dst))
+	assert.True(t, secp256k1.S256().IsOnCurve(x, y))
+}
+
+func testEdwards25519(t *testing.T, f func(msg, dst []byte) (*ge25519.Ge25519, error), v suiteVector) {
+	for i, msg := range msgs {
+		p, err := f([]byte(msg), []byte(v.dst))
+		assert.Nil(t, err)
+		// the encoding is y in little endian with the sign of x in the top bit
+		var expect [32]byte
+		fromVector(v.points[i][1]).FillBytes(expect[:])
+		for j := 0; j < 16; j++ {
+			expect[j], expect[31-j] = expect[31-j], expect[j]
+		}
+		expect[31] |= byte(fromVector(v.points[i][0]).Bit(0)) << 7
+		var got [32]byte
+		p.ToBytes(&got)
+		assert.Equal(t, hex.EncodeToString(expect[:]), hex.EncodeToString(got[:]), msg)
+	}
+	_, err := f([]byte("abc"), nil)
+	assert.NotNil(t, err)
+}
+
+func TestEdwards25519(t *testing.T) {
+	testEdwards25519(t, HashToEdwards25519, hashToEdwards25519Vector)
+	testEdwards25519(t, EncodeToEdwards25519, encodeToEdwards25519Vector)
+}
@@ -0,0 +1,44 @@
+package h2c
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+// p256SSWU maps to P-256 directly, Z = -10
+var p256SSWU = func() *sswu {
+	params := elliptic.P256().Params()
+	return &sswu{
+		p: params.P,
+		a: new(big.Int).Sub(params.P, big.NewInt(3)),
+		b: params.B,
+		z: new(big.Int).Sub(params.P, big.NewInt(10)),
+	}
+}()
+
+//HashToP256 hash msg to a point of P-256 with the suite P256_XMD:SHA-256_SSWU_RO_,
+// dst is the domain separation tag of the application and must not be empty.
+func HashToP256(msg, dst []byte) (x, y *big.Int, err error) {
+	u, err := hashToField(hash.SHA2_256, msg, dst, p256SSWU.p, 48, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	x0, y0 := p256SSWU.mapToCurve(u[0])
+	x1, y1 := p256SSWU.mapToCurve(u[1])
+	// the cofactor of P-256 is 1
+	x, y = elliptic.P256().Add(x0, y0, x1, y1)
+	return x, y, nil
+}
+
+//EncodeToP256 encode msg to a point of P-256 with the suite P256_XMD:SHA-256_SSWU_NU_,
+// the output is not uniformly distributed.
+func EncodeToP256(msg, dst []byte) (x, y *big.Int, err error) {
+	u, err := hashToField(hash.SHA2_256, msg, dst, p256SSWU.p, 48, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	x, y = p256SSWU.mapToCurve(u[0])
+	return x, y, nil
+}
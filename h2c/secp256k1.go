@@ -0,0 +1,96 @@
+package h2c
+
+import (
+	"math/big"
+
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+// secp256k1 has a = 0, so SSWU maps to the 3-isogenous curve
+// y^2 = x^3 + A' * x + B' of RFC 9380 section 8.7, Z = -11
+var secp256k1SSWU = func() *sswu {
+	p := secp256k1.S256().Params().P
+	return &sswu{
+		p: p,
+		a: fromHex("3f8731abdd661adca08a5558f0f5d272e953d363cb6f0e5d405447c01a444533"),
+		b: big.NewInt(1771),
+		z: new(big.Int).Sub(p, big.NewInt(11)),
+	}
+}()
+
+// coefficients of the 3-isogeny map of RFC 9380 appendix E.1, lowest degree first
+var (
+	isoXNum = []*big.Int{
+		fromHex("8e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38daaaaa8c7"),
+		fromHex("07d3d4c80bc321d5b9f315cea7fd44c5d595d2fc0bf63b92dfff1044f17c6581"),
+		fromHex("534c328d23f234e6e2a413deca25caece4506144037c40314ecbd0b53d9dd262"),
+		fromHex("8e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38daaaaa88c"),
+	}
+	isoXDen = []*big.Int{
+		fromHex("d35771193d94918a9ca34ccbb7b640dd86cd409542f8487d9fe6b745781eb49b"),
+		fromHex("edadc6f64383dc1df7c4b2d51b54225406d36b641f5e41bbc52a56612a8c6d14"),
+		big.NewInt(1),
+	}
+	isoYNum = []*big.Int{
+		fromHex("4bda12f684bda12f684bda12f684bda12f684bda12f684bda12f684b8e38e23c"),
+		fromHex("c75e0c32d5cb7c0fa9d0a54b12a0a6d5647ab046d686da6fdffc90fc201d71a3"),
+		fromHex("29a6194691f91a73715209ef6512e576722830a201be2018a765e85a9ecee931"),
+		fromHex("2f684bda12f684bda12f684bda12f684bda12f684bda12f684bda12f38e38d84"),
+	}
+	isoYDen = []*big.Int{
+		fromHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffff93b"),
+		fromHex("7a06534bb8bdb49fd5e9e6632722c2989467c1bfc8e8d978dfb425d2685c2573"),
+		fromHex("6484aa716545ca2cf3a70c3fa8fe337e0a3d21162f0d6299a7bf8192bfd2a76f"),
+		big.NewInt(1),
+	}
+)
+
+// polynomial evaluates the polynomial of coefficients k at x with Horner's rule
+func polynomial(k []*big.Int, x, p *big.Int) *big.Int {
+	r := new(big.Int).Set(k[len(k)-1])
+	for i := len(k) - 2; i >= 0; i-- {
+		r.Mul(r, x)
+		r.Add(r, k[i])
+		r.Mod(r, p)
+	}
+	return r
+}
+
+// isoMap maps a point of the isogenous curve to secp256k1.
+// The exceptional cases map to the identity, which the SSWU output never hits.
+func isoMap(x, y *big.Int) (*big.Int, *big.Int) {
+	p := secp256k1SSWU.p
+	xd := new(big.Int).ModInverse(polynomial(isoXDen, x, p), p)
+	yd := new(big.Int).ModInverse(polynomial(isoYDen, x, p), p)
+	xo := polynomial(isoXNum, x, p)
+	xo.Mul(xo, xd).Mod(xo, p)
+	yo := polynomial(isoYNum, x, p)
+	yo.Mul(yo, yd).Mul(yo, y).Mod(yo, p)
+	return xo, yo
+}
+
+//HashToSecp256k1 hash msg to a point of secp256k1 with the suite secp256k1_XMD:SHA-256_SSWU_RO_,
+// dst is the domain separation tag of the application and must not be empty.
+func HashToSecp256k1(msg, dst []byte) (x, y *big.Int, err error) {
+	u, err := hashToField(hash.SHA2_256, msg, dst, secp256k1SSWU.p, 48, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	x0, y0 := isoMap(secp256k1SSWU.mapToCurve(u[0]))
+	x1, y1 := isoMap(secp256k1SSWU.mapToCurve(u[1]))
+	// the cofactor of secp256k1 is 1
+	x, y = secp256k1.S256().Add(x0, y0, x1, y1)
+	return x, y, nil
+}
+
+//EncodeToSecp256k1 encode msg to a point of secp256k1 with the suite secp256k1_XMD:SHA-256_SSWU_NU_,
+// the output is not uniformly distributed.
+func EncodeToSecp256k1(msg, dst []byte) (x, y *big.Int, err error) {
+	u, err := hashToField(hash.SHA2_256, msg, dst, secp256k1SSWU.p, 48, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	x, y = isoMap(secp256k1SSWU.mapToCurve(u[0]))
+	return x, y, nil
+}
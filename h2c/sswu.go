@@ -0,0 +1,57 @@
+package h2c
+
+import (
+	"math/big"
+)
+
+// sswu is the simplified Shallue-van de Woestijne-Ulas method of RFC 9380
+// section 6.6.2 for y^2 = x^3 + a * x + b over GF(p), a and b must be non-zero.
+type sswu struct {
+	p, a, b, z *big.Int
+}
+
+// mapToCurve returns the point of u on the curve of s, it is not constant time.
+func (s *sswu) mapToCurve(u *big.Int) (x, y *big.Int) {
+	p := s.p
+	mul := func(a, b *big.Int) *big.Int {
+		r := new(big.Int).Mul(a, b)
+		return r.Mod(r, p)
+	}
+	inv := func(a *big.Int) *big.Int {
+		return new(big.Int).ModInverse(a, p)
+	}
+	// g(x) = x^3 + a * x + b
+	g := func(x *big.Int) *big.Int {
+		r := mul(mul(x, x), x)
+		r.Add(r, mul(s.a, x))
+		r.Add(r, s.b)
+		return r.Mod(r, p)
+	}
+
+	// tv1 = inv0(Z^2 * u^4 + Z * u^2)
+	zu2 := mul(s.z, mul(u, u))
+	tv1 := mul(zu2, zu2)
+	tv1.Add(tv1, zu2)
+	tv1.Mod(tv1, p)
+	var x1 *big.Int
+	if tv1.Sign() == 0 {
+		// x1 = B / (Z * A)
+		x1 = mul(s.b, inv(mul(s.z, s.a)))
+	} else {
+		// x1 = (-B / A) * (1 + tv1)
+		tv1 = inv(tv1)
+		tv1.Add(tv1, big.NewInt(1))
+		x1 = mul(new(big.Int).Neg(s.b), inv(s.a))
+		x1 = mul(x1, tv1)
+	}
+	x = x1
+	y = new(big.Int).ModSqrt(g(x1), p)
+	if y == nil {
+		x = mul(zu2, x1)
+		y = new(big.Int).ModSqrt(g(x), p)
+	}
+	if u.Bit(0) != y.Bit(0) {
+		y.Mod(y.Sub(p, y), p)
+	}
+	return x, y
+}
@@ -10,6 +10,9 @@ const (
 	SHA2   HashType = 0x20
 	SHA3   HashType = 0x30
 	KECCAK HashType = 0x40
+	//KANGAROO KangarooTwelve and TURBOSHAKE TurboSHAKE of RFC 9861, the size is the default output
+	KANGAROO   HashType = 0x70
+	TURBOSHAKE HashType = 0x80
 
 	Size224 HashType = 0x01
 	Size256 HashType = 0x00
@@ -40,4 +43,12 @@ const (
 	KECCAK_384 = KECCAK | Size384
 	//KECCAK_512 KECCAK with 512bits
 	KECCAK_512 = KECCAK | Size512
+	//KT128 KangarooTwelve KT128 with 256bits
+	KT128 = KANGAROO | Size256
+	//KT256 KangarooTwelve KT256 with 512bits
+	KT256 = KANGAROO | Size512
+	//TURBOSHAKE_128 TurboSHAKE128 with 256bits
+	TURBOSHAKE_128 = TURBOSHAKE | Size256
+	//TURBOSHAKE_256 TurboSHAKE256 with 512bits
+	TURBOSHAKE_256 = TURBOSHAKE | Size512
 )
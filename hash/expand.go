@@ -0,0 +1,99 @@
+package hash
+
+import (
+	"encoding/binary"
+	"errors"
+
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+)
+
+// oversizeDSTPrefix is hashed with domain separation tags longer than 255 bytes
+const oversizeDSTPrefix = "H2C-OVERSIZE-DST-"
+
+//error defines
+var (
+	ErrExpandLength = errors.New("hash: requested length is too large to expand")
+	ErrEmptyDST     = errors.New("hash: domain separation tag is empty")
+)
+
+//ExpandMessageXMD implements expand_message_xmd of RFC 9380 section 5.3.1,
+// it expands msg into lenInBytes uniform bytes with the Merkle-Damgard hash of hashType
+// under the domain separation tag dst. Tags longer than 255 bytes are hashed first.
+func ExpandMessageXMD(hashType HashType, msg, dst []byte, lenInBytes int) ([]byte, error) {
+	h, err := New(hashType)
+	if err != nil {
+		return nil, err
+	}
+	if len(dst) == 0 {
+		return nil, ErrEmptyDST
+	}
+	size := h.Size()
+	ell := (lenInBytes + size - 1) / size
+	if ell > 255 || lenInBytes > 0xffff || lenInBytes <= 0 {
+		return nil, ErrExpandLength
+	}
+	if len(dst) > 255 {
+		if dst, err = h.BatchHash([][]byte{[]byte(oversizeDSTPrefix), dst}); err != nil {
+			return nil, err
+		}
+	}
+	dstPrime := append(append([]byte(nil), dst...), byte(len(dst)))
+	// l_i_b_str followed by I2OSP(0, 1)
+	var lib [3]byte
+	binary.BigEndian.PutUint16(lib[:], uint16(lenInBytes))
+
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	b0, err := h.BatchHash([][]byte{make([]byte, h.BlockSize()), msg, lib[:], dstPrime})
+	if err != nil {
+		return nil, err
+	}
+	// b_1 = H(b_0 || I2OSP(1, 1) || DST_prime)
+	bi, err := h.BatchHash([][]byte{b0, {1}, dstPrime})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, ell*size)
+	out = append(out, bi...)
+	tmp := make([]byte, size)
+	for i := 2; i <= ell; i++ {
+		// b_i = H(strxor(b_0, b_(i - 1)) || I2OSP(i, 1) || DST_prime)
+		for j := range tmp {
+			tmp[j] = b0[j] ^ bi[j]
+		}
+		if bi, err = h.BatchHash([][]byte{tmp, {byte(i)}, dstPrime}); err != nil {
+			return nil, err
+		}
+		out = append(out, bi...)
+	}
+	return out[:lenInBytes], nil
+}
+
+//ExpandMessageXOF implements expand_message_xof of RFC 9380 section 5.3.2 with a fresh
+// extendable-output function such as sha3.NewShake128(). Tags longer than 255 bytes are
+// hashed into xof.Size() bytes first, that is ceil(2 * k / 8) for the SHAKE functions.
+func ExpandMessageXOF(xof sha3Hash.ShakeHash, msg, dst []byte, lenInBytes int) ([]byte, error) {
+	if len(dst) == 0 {
+		return nil, ErrEmptyDST
+	}
+	if lenInBytes > 0xffff || lenInBytes <= 0 {
+		return nil, ErrExpandLength
+	}
+	xof.Reset()
+	if len(dst) > 255 {
+		_, _ = xof.Write([]byte(oversizeDSTPrefix))
+		_, _ = xof.Write(dst)
+		dst = make([]byte, xof.Size())
+		_, _ = xof.Read(dst)
+		xof.Reset()
+	}
+	var lib [2]byte
+	binary.BigEndian.PutUint16(lib[:], uint16(lenInBytes))
+	// msg_prime = msg || I2OSP(len_in_bytes, 2) || DST || I2OSP(len(DST), 1)
+	_, _ = xof.Write(msg)
+	_, _ = xof.Write(lib[:])
+	_, _ = xof.Write(dst)
+	_, _ = xof.Write([]byte{byte(len(dst))})
+	out := make([]byte, lenInBytes)
+	_, _ = xof.Read(out)
+	return out, nil
+}
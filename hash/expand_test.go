@@ -0,0 +1,67 @@
+package hash
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+	"github.com/stretchr/testify/assert"
+)
+
+// vectors of RFC 9380 appendix K
+func TestExpandMessageXMD(t *testing.T) {
+	tests := []struct {
+		hashType HashType
+		dst      string
+		msg      string
+		length   int
+		expect   string
+	}{
+		{SHA2_256, "QUUX-V01-CS02-with-expander-SHA256-128", "abc", 32, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+		{SHA2_256, "QUUX-V01-CS02-with-expander-SHA256-128", "abcdef0123456789", 128, "ef904a29bffc4cf9ee82832451c946ac3c8f8058ae97d8d629831a74c6572bd9ebd0df635cd1f208e2038e760c4994984ce73f0d55ea9f22af83ba4734569d4bc95e18350f740c07eef653cbb9f87910d833751825f0ebefa1abe5420bb52be14cf489b37fe1a72f7de2d10be453b2c9d9eb20c7e3f6edc5a60629178d9478df"},
+		{SHA2_256, "QUUX-V01-CS02-with-expander-SHA256-128-long-DST-" + strings.Repeat("1", 208), "abc", 32, "52dbf4f36cf560fca57dedec2ad924ee9c266341d8f3d6afe5171733b16bbb12"},
+		{SHA2_256, "QUUX-V01-CS02-with-expander-SHA256-128-long-DST-" + strings.Repeat("1", 208), "abcdef0123456789", 128, "d2ecef3635d2397f34a9f86438d772db19ffe9924e28a1caf6f1c8f15603d4028f40891044e5c7e39ebb9b31339979ff33a4249206f67d4a1e7c765410bcd249ad78d407e303675918f20f26ce6d7027ed3774512ef5b00d816e51bfcc96c3539601fa48ef1c07e494bdc37054ba96ecb9dbd666417e3de289d4f424f502a982"},
+		{SHA2_512, "QUUX-V01-CS02-with-expander-SHA512-256", "abc", 32, "0da749f12fbe5483eb066a5f595055679b976e93abe9be6f0f6318bce7aca8dc"},
+		{SHA2_512, "QUUX-V01-CS02-with-expander-SHA512-256", "abcdef0123456789", 128, "3f721f208e6199fe903545abc26c837ce59ac6fa45733f1baaf0222f8b7acb0424814fcb5eecf6c1d38f06e9d0a6ccfbf85ae612ab8735dfdf9ce84c372a77c8f9e1c1e952c3a61b7567dd0693016af51d2745822663d0c2367e3f4f0bed827feecc2aaf98c949b5ed0d35c3f1023d64ad1407924288d366ea159f46287e61ac"},
+	}
+	for _, test := range tests {
+		r, err := ExpandMessageXMD(test.hashType, []byte(test.msg), []byte(test.dst), test.length)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expect, hex.EncodeToString(r))
+	}
+
+	_, err := ExpandMessageXMD(SHA2_256, nil, nil, 32)
+	assert.Equal(t, ErrEmptyDST, err)
+	_, err = ExpandMessageXMD(SHA2_256, nil, []byte("DST"), 255*32+1)
+	assert.Equal(t, ErrExpandLength, err)
+	_, err = ExpandMessageXMD(HashType(0xff), nil, []byte("DST"), 32)
+	assert.Equal(t, UnsupportedHashError(0xff), err)
+}
+
+func TestExpandMessageXOF(t *testing.T) {
+	tests := []struct {
+		xof    func() sha3Hash.ShakeHash
+		dst    string
+		msg    string
+		length int
+		expect string
+	}{
+		{sha3Hash.NewShake128, "QUUX-V01-CS02-with-expander-SHAKE128", "abc", 32, "8696af52a4d862417c0763556073f47bc9b9ba43c99b505305cb1ec04a9ab468"},
+		{sha3Hash.NewShake128, "QUUX-V01-CS02-with-expander-SHAKE128", "abcdef0123456789", 128, "19b65ee7afec6ac06a144f2d6134f08eeec185f1a890fe34e68f0e377b7d0312883c048d9b8a1d6ecc3b541cb4987c26f45e0c82691ea299b5e6889bbfe589153016d8131717ba26f07c3c14ffbef1f3eff9752e5b6183f43871a78219a75e7000fbac6a7072e2b83c790a3a5aecd9d14be79f9fd4fb180960a3772e08680495"},
+		{sha3Hash.NewShake128, "QUUX-V01-CS02-with-expander-SHAKE128-long-DST-" + strings.Repeat("1", 210), "abc", 32, "690c8d82c7213b4282c6cb41c00e31ea1d3e2005f93ad19bbf6da40f15790c5c"},
+		{sha3Hash.NewShake128, "QUUX-V01-CS02-with-expander-SHAKE128-long-DST-" + strings.Repeat("1", 210), "abcdef0123456789", 128, "55317e4a21318472cd2290c3082957e1242241d9e0d04f47026f03401643131401071f01aa03038b2783e795bdfa8a3541c194ad5de7cb9c225133e24af6c86e748deb52e560569bd54ef4dac03465111a3a44b0ea490fb36777ff8ea9f1a8a3e8e0de3cf0880b4b2f8dd37d3a85a8b82375aee4fa0e909f9763319b55778e71"},
+		{sha3Hash.NewShake256, "QUUX-V01-CS02-with-expander-SHAKE256", "abc", 32, "b39e493867e2767216792abce1f2676c197c0692aed061560ead251821808e07"},
+		{sha3Hash.NewShake256, "QUUX-V01-CS02-with-expander-SHAKE256", "abcdef0123456789", 128, "e42e4d9538a189316e3154b821c1bafb390f78b2f010ea404e6ac063deb8c0852fcd412e098e231e43427bd2be1330bb47b4039ad57b30ae1fc94e34993b162ff4d695e42d59d9777ea18d3848d9d336c25d2acb93adcad009bcfb9cde12286df267ada283063de0bb1505565b2eb6c90e31c48798ecdc71a71756a9110ff373"},
+	}
+	for _, test := range tests {
+		r, err := ExpandMessageXOF(test.xof(), []byte(test.msg), []byte(test.dst), test.length)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expect, hex.EncodeToString(r))
+	}
+
+	_, err := ExpandMessageXOF(sha3Hash.NewShake128(), nil, nil, 32)
+	assert.Equal(t, ErrEmptyDST, err)
+	_, err = ExpandMessageXOF(sha3Hash.NewShake128(), nil, []byte("DST"), 0x10000)
+	assert.Equal(t, ErrExpandLength, err)
+}
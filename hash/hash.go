@@ -1,67 +1,72 @@
 package hash
 
 import (
-	"crypto/sha1"
-	"crypto/sha256"
-	"crypto/sha512"
-	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"hash"
+
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
 )
 
 //Hasher thw return value of function NewHasher
 type Hasher struct {
-	inner hash.Hash
-	dirty bool
+	inner    hash.Hash
+	hashType HashType
+	dirty    bool
 }
 
 //NewHasher instruct a Hasher, the incoming parameter is the algorithm type.
+// Algorithms added by RegisterHash are supported as well as the built-in ones.
 func NewHasher(hashType HashType) *Hasher {
-	ht, size := hashType&0xf0, hashType&0x0f
-	switch ht {
-	case SHA1:
-		return &Hasher{inner: sha1.New()}
-	case SHA2:
-		switch size {
-		case Size224:
-			return &Hasher{inner: sha256.New224()}
-		case Size256:
-			return &Hasher{inner: sha256.New()}
-		case Size384:
-			return &Hasher{inner: sha512.New384()}
-		case Size512:
-			return &Hasher{inner: sha512.New()}
-		default:
-			return nil
-		}
-	case SHA3:
-		switch size {
-		case Size224:
-			return &Hasher{inner: sha3Hash.New224()}
-		case Size256:
-			return &Hasher{inner: sha3Hash.New256()}
-		case Size384:
-			return &Hasher{inner: sha3Hash.New384()}
-		case Size512:
-			return &Hasher{inner: sha3Hash.New512()}
-		default:
-			return nil
-		}
-	case KECCAK:
-		switch size {
-		case Size224:
-			return &Hasher{inner: sha3Hash.NewKeccak224()}
-		case Size256:
-			return &Hasher{inner: sha3Hash.NewKeccak256()}
-		case Size384:
-			return &Hasher{inner: sha3Hash.NewKeccak384()}
-		case Size512:
-			return &Hasher{inner: sha3Hash.NewKeccak512()}
-		default:
-			return nil
-		}
+	inner := newInner(hashType)
+	if inner == nil {
+		return nil
+	}
+	return &Hasher{inner: inner, hashType: hashType}
+}
+
+//UnsupportedHashError is returned by New if the hash type is unknown
+type UnsupportedHashError HashType
+
+func (e UnsupportedHashError) Error() string {
+	return fmt.Sprintf("hash: unsupported hash type 0x%x", uint32(e))
+}
+
+//New instruct a Hasher like NewHasher, but return UnsupportedHashError
+// instead of a nil Hasher if hashType is unknown.
+func New(hashType HashType) (*Hasher, error) {
+	h := NewHasher(hashType)
+	if h == nil {
+		return nil, UnsupportedHashError(hashType)
+	}
+	return h, nil
+}
+
+//NewCustomHasher instruct a Hasher of KT128 or KT256 with the customization string custom,
+// which separates the uses of the hash like the domain of a signature. Hasher.Reset
+// keeps the customization string. Other hash types return an error.
+func NewCustomHasher(hashType HashType, custom []byte) (*Hasher, error) {
+	var inner hash.Hash
+	switch hashType {
+	case KT128:
+		inner = sha3Hash.NewKT128(custom)
+	case KT256:
+		inner = sha3Hash.NewKT256(custom)
 	default:
+		return nil, fmt.Errorf("hash: hash type %v has no customization string", hashType)
+	}
+	return &Hasher{inner: inner, hashType: hashType}, nil
+}
+
+// newInner returns the registered hash of hashType or nil
+func newInner(hashType HashType) hash.Hash {
+	info := lookup(hashType)
+	if info == nil {
 		return nil
 	}
+	return info.newHash()
 }
 
 //Write write data
@@ -69,7 +74,7 @@ func (h *Hasher) Write(p []byte) (n int, err error) {
 	return h.inner.Write(p)
 }
 
-//Sum get sum
+//Sum append the hash to b and return the resulting slice, like hash.Hash
 func (h *Hasher) Sum(b []byte) []byte {
 	return h.inner.Sum(b)
 }
@@ -99,10 +104,9 @@ func (h *Hasher) Hash(msg []byte) (hash []byte, err error) {
 	return h.inner.Sum(nil), nil
 }
 
-// HashBuffer is identical to Hash except that it stages through the
-// provided buffer (if one is required) rather than allocating a
-// temporary one. If buf is nil or has un-expected size, one fix-sized
-// bytes will be allocated.
+// HashBuffer is identical to Hash except that it appends the hash to buf,
+// reusing its spare capacity rather than allocating a new slice. Pass
+// buf[:0] to get the hash alone.
 func (h *Hasher) HashBuffer(msg []byte, buf []byte) (hash []byte, err error) {
 	h.cleanIfDirty()
 	h.dirty = true
@@ -125,6 +129,68 @@ func (h *Hasher) BatchHash(msg [][]byte) (hash []byte, err error) {
 	return h.inner.Sum(nil), nil
 }
 
+//Clone return a Hasher with the same algorithm and internal state,
+// writing to the copy does not affect h and vice versa.
+func (h *Hasher) Clone() (*Hasher, error) {
+	if c, ok := h.inner.(interface{ Clone() hash.Hash }); ok {
+		return &Hasher{inner: c.Clone(), hashType: h.hashType, dirty: h.dirty}, nil
+	}
+	state, err := h.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	ret := new(Hasher)
+	if err = ret.UnmarshalBinary(state); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// hasherStateHeader is the size of hashType and dirty flag which prefix
+// the state of the underlying hash in MarshalBinary.
+const hasherStateHeader = 5
+
+//MarshalBinary implements encoding.BinaryMarshaler, the state records the
+// algorithm, so it can be restored by UnmarshalBinary on an empty Hasher.
+func (h *Hasher) MarshalBinary() ([]byte, error) {
+	m, ok := h.inner.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("hash type 0x%x does not support marshaling", uint32(h.hashType))
+	}
+	inner, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]byte, hasherStateHeader, hasherStateHeader+len(inner))
+	binary.BigEndian.PutUint32(ret, uint32(h.hashType))
+	if h.dirty {
+		ret[4] = 1
+	}
+	return append(ret, inner...), nil
+}
+
+//UnmarshalBinary implements encoding.BinaryUnmarshaler, it restores the
+// state produced by MarshalBinary, h may be a zero Hasher.
+func (h *Hasher) UnmarshalBinary(data []byte) error {
+	if len(data) < hasherStateHeader || data[4] > 1 {
+		return errors.New("invalid hasher state")
+	}
+	hashType := HashType(binary.BigEndian.Uint32(data))
+	inner := h.inner
+	if inner == nil || h.hashType != hashType {
+		inner = newInner(hashType)
+	}
+	u, ok := inner.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return fmt.Errorf("hash type 0x%x does not support unmarshaling", uint32(hashType))
+	}
+	if err := u.UnmarshalBinary(data[hasherStateHeader:]); err != nil {
+		return err
+	}
+	h.inner, h.hashType, h.dirty = inner, hashType, data[4] == 1
+	return nil
+}
+
 func (h *Hasher) cleanIfDirty() {
 	if h.dirty {
 		h.inner.Reset()
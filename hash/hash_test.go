@@ -2,9 +2,14 @@ package hash
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/asn1"
 	"encoding/hex"
 	"reflect"
+	"runtime"
+	"sync"
 	"testing"
+	"testing/iotest"
 	"unsafe"
 
 	"github.com/stretchr/testify/assert"
@@ -212,3 +217,285 @@ func TestHashBuffer(t *testing.T) {
 		assert.Equal(t, p1.Data, p2.Data)
 	}
 }
+
+func TestHasherClone(t *testing.T) {
+	types := []HashType{SHA1, SHA2_224, SHA2_256, SHA2_384, SHA2_512,
+		SHA3_224, SHA3_256, SHA3_384, SHA3_512, KECCAK_224, KECCAK_256, KECCAK_384, KECCAK_512}
+	half := len(msg) / 2
+	for _, typ := range types {
+		expect, err := NewHasher(typ).Hash([]byte(msg))
+		assert.Nil(t, err)
+
+		hasher := NewHasher(typ)
+		_, _ = hasher.Write([]byte(msg[:half]))
+		fork, err := hasher.Clone()
+		assert.Nil(t, err)
+		_, _ = hasher.Write([]byte("another suffix"))
+		_, _ = fork.Write([]byte(msg[half:]))
+		assert.Equal(t, expect, fork.Sum(nil))
+		assert.NotEqual(t, expect, hasher.Sum(nil))
+	}
+}
+
+func TestHasherMarshalBinary(t *testing.T) {
+	types := []HashType{SHA1, SHA2_224, SHA2_256, SHA2_384, SHA2_512,
+		SHA3_224, SHA3_256, SHA3_384, SHA3_512, KECCAK_224, KECCAK_256, KECCAK_384, KECCAK_512,
+		KT128, KT256, TURBOSHAKE_128, TURBOSHAKE_256}
+	for _, typ := range types {
+		expect, err := NewHasher(typ).Hash([]byte(msg))
+		assert.Nil(t, err)
+		for _, split := range []int{0, 1, 71, 136, len(msg) - 1, len(msg)} {
+			hasher := NewHasher(typ)
+			_, _ = hasher.Write([]byte(msg[:split]))
+			state, err := hasher.MarshalBinary()
+			assert.Nil(t, err)
+
+			restored := new(Hasher)
+			assert.Nil(t, restored.UnmarshalBinary(state))
+			_, _ = restored.Write([]byte(msg[split:]))
+			assert.Equal(t, expect, restored.Sum(nil))
+		}
+	}
+
+	// a dirty Hasher is reset by the next Hash after restoring
+	hasher := NewHasher(KECCAK_256)
+	_, _ = hasher.Hash([]byte("hello"))
+	state, err := hasher.MarshalBinary()
+	assert.Nil(t, err)
+	restored := new(Hasher)
+	assert.Nil(t, restored.UnmarshalBinary(state))
+	hash, err := restored.Hash([]byte(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, keccak256Expect, hex.EncodeToString(hash))
+
+	// the customization string is a part of the state
+	custom, _ := NewCustomHasher(KT128, []byte("custom"))
+	expect, _ := custom.Hash([]byte(msg))
+	custom, _ = NewCustomHasher(KT128, []byte("custom"))
+	_, _ = custom.Write([]byte(msg[:100]))
+	state, err = custom.MarshalBinary()
+	assert.Nil(t, err)
+	restored = new(Hasher)
+	assert.Nil(t, restored.UnmarshalBinary(state))
+	_, _ = restored.Write([]byte(msg[100:]))
+	assert.Equal(t, expect, restored.Sum(nil))
+
+	// state of another algorithm is rejected
+	state, err = NewHasher(SHA3_256).MarshalBinary()
+	assert.Nil(t, err)
+	assert.NotNil(t, NewHasher(KECCAK_256).inner.(interface{ UnmarshalBinary([]byte) error }).UnmarshalBinary(state[hasherStateHeader:]))
+	assert.NotNil(t, new(Hasher).UnmarshalBinary(state[:3]))
+}
+
+func TestHashMany(t *testing.T) {
+	types := []HashType{SHA1, SHA2_256, SHA2_512, SHA3_224, SHA3_256, SHA3_512, KECCAK_256, KECCAK_384}
+	var msgs [][]byte
+	for _, l := range []int{0, 1, 31, 71, 72, 135, 136, 137, 272, 500, len(msg)} {
+		msgs = append(msgs, []byte(msg[:l]))
+	}
+	// more than one worker
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	for i := 0; i < 300; i++ {
+		msgs = append(msgs, []byte(msg[i:i+i%150]))
+	}
+	for _, typ := range types {
+		digests := HashMany(typ, msgs)
+		assert.Equal(t, len(msgs), len(digests))
+		hasher := NewHasher(typ)
+		for i := range msgs {
+			expect, err := hasher.Hash(msgs[i])
+			assert.Nil(t, err)
+			assert.Equal(t, expect, digests[i])
+		}
+	}
+	assert.Nil(t, HashMany(HashType(0xff), msgs))
+	assert.Equal(t, 0, len(HashMany(KECCAK_256, nil)))
+}
+
+func TestNew(t *testing.T) {
+	h, err := New(KECCAK_256)
+	assert.Nil(t, err)
+	r, err := h.Hash([]byte(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, keccak256Expect, hex.EncodeToString(r))
+
+	h, err = New(HashType(0xff))
+	assert.Nil(t, h)
+	assert.Equal(t, UnsupportedHashError(0xff), err)
+	assert.Equal(t, "hash: unsupported hash type 0xff", err.Error())
+}
+
+func TestSum(t *testing.T) {
+	expects := map[HashType]string{
+		SHA1:       sha1Expect,
+		SHA2_256:   sha2_256Expect,
+		KECCAK_256: keccak256Expect,
+		SHA3_512:   sha3_512Expect,
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				for typ, expect := range expects {
+					r, err := Sum(typ, []byte(msg))
+					assert.Nil(t, err)
+					assert.Equal(t, expect, hex.EncodeToString(r))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	_, err := Sum(HashType(0xff), []byte(msg))
+	assert.Equal(t, UnsupportedHashError(0xff), err)
+}
+
+func TestRegistry(t *testing.T) {
+	assert.Equal(t, "SHA3-256", SHA3_256.String())
+	assert.Equal(t, "KECCAK-256", KECCAK_256.String())
+	assert.Equal(t, "HashType(0xff)", HashType(0xff).String())
+	assert.False(t, HashType(0xff).Available())
+
+	for name, expect := range map[string]HashType{
+		"SHA3-256":   SHA3_256,
+		"sha3_256":   SHA3_256,
+		"SHA-256":    SHA2_256,
+		"sha256":     SHA2_256,
+		"SHA2-512":   SHA2_512,
+		"sha1":       SHA1,
+		"keccak256":  KECCAK_256,
+		"Keccak-512": KECCAK_512,
+	} {
+		typ, err := ParseHashType(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, expect, typ, name)
+	}
+	_, err := ParseHashType("MD5")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "2.16.840.1.101.3.4.2.8", SHA3_256.OID().String())
+	assert.Nil(t, KECCAK_256.OID())
+	typ, err := HashTypeFromOID(asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, SHA2_256, typ)
+	_, err = HashTypeFromOID(asn1.ObjectIdentifier{1, 2, 3})
+	assert.NotNil(t, err)
+}
+
+func TestRegisterHash(t *testing.T) {
+	custom := HashType(0x7f)
+	oid := asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 1}
+	assert.Nil(t, RegisterHash(custom, "Test-SHA256", oid, sha256.New))
+	assert.NotNil(t, RegisterHash(custom, "Other", nil, sha256.New))
+	assert.NotNil(t, RegisterHash(0x7e, "test_sha256", nil, sha256.New))
+	assert.NotNil(t, RegisterHash(0x7e, "Other", oid, sha256.New))
+	assert.NotNil(t, RegisterHash(SHA3_256, "Other", nil, sha256.New))
+	assert.NotNil(t, RegisterHash(0x7e, "", nil, sha256.New))
+
+	assert.Equal(t, "Test-SHA256", custom.String())
+	typ, err := ParseHashType("TEST-SHA256")
+	assert.Nil(t, err)
+	assert.Equal(t, custom, typ)
+	typ, err = HashTypeFromOID(oid)
+	assert.Nil(t, err)
+	assert.Equal(t, custom, typ)
+
+	r, err := NewHasher(custom).Hash([]byte(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, sha2_256Expect, hex.EncodeToString(r))
+	r, err = Sum(custom, []byte(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, sha2_256Expect, hex.EncodeToString(r))
+	assert.Equal(t, sha2_256Expect, hex.EncodeToString(HashMany(custom, [][]byte{[]byte(msg)})[0]))
+}
+
+func TestMultiHasher(t *testing.T) {
+	types := []HashType{SHA2_256, KECCAK_256, SHA3_256}
+	m, err := NewMultiHasher(types...)
+	assert.Nil(t, err)
+	assert.Equal(t, types, m.Types())
+	for i := 0; i < len(msg); i += 100 {
+		end := i + 100
+		if end > len(msg) {
+			end = len(msg)
+		}
+		_, _ = m.Write([]byte(msg[i:end]))
+	}
+	sums := m.Sums()
+	assert.Equal(t, sha2_256Expect, hex.EncodeToString(sums[0]))
+	assert.Equal(t, keccak256Expect, hex.EncodeToString(sums[1]))
+	assert.Equal(t, sha3_256Expect, hex.EncodeToString(sums[2]))
+	assert.Equal(t, keccak256Expect, hex.EncodeToString(m.Sum(KECCAK_256)))
+	assert.Nil(t, m.Sum(SHA1))
+
+	m.Reset()
+	_, _ = m.Write([]byte(msg))
+	assert.Equal(t, sums, m.Sums())
+
+	_, err = NewMultiHasher(SHA1, HashType(0xff))
+	assert.Equal(t, UnsupportedHashError(0xff), err)
+}
+
+func TestHashReader(t *testing.T) {
+	// bigger than the ring of chunks
+	data := bytes.Repeat([]byte(msg), 2000)
+	types := []HashType{SHA2_256, KECCAK_256, SHA3_512}
+	for _, procs := range []int{1, 4} {
+		old := runtime.GOMAXPROCS(procs)
+		sums, err := HashReader(iotest.HalfReader(bytes.NewReader(data)), types...)
+		runtime.GOMAXPROCS(old)
+		assert.Nil(t, err)
+		for i, typ := range types {
+			expect, _ := Sum(typ, data)
+			assert.Equal(t, expect, sums[i])
+		}
+	}
+
+	old := runtime.GOMAXPROCS(4)
+	defer runtime.GOMAXPROCS(old)
+	_, err := HashReader(iotest.TimeoutReader(bytes.NewReader(data)), types...)
+	assert.Equal(t, iotest.ErrTimeout, err)
+	sums, err := HashReader(bytes.NewReader(nil), SHA2_256)
+	assert.Nil(t, err)
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", hex.EncodeToString(sums[0]))
+}
+
+func TestKangarooTwelve(t *testing.T) {
+	// RFC 9861 section 5, ptn(17) and an empty message with C = ptn(1)
+	ptn := make([]byte, 17)
+	for i := range ptn {
+		ptn[i] = byte(i)
+	}
+	typ, err := ParseHashType("KangarooTwelve")
+	assert.Nil(t, err)
+	assert.Equal(t, KT128, typ)
+	r, err := NewHasher(KT128).Hash(ptn)
+	assert.Nil(t, err)
+	assert.Equal(t, "6bf75fa2239198db4772e36478f8e19b0f371205f6a9a93a273f51df37122888", hex.EncodeToString(r))
+	assert.Equal(t, [][]byte{r}, HashMany(KT128, [][]byte{ptn}))
+
+	hasher, err := NewCustomHasher(KT128, ptn[:1])
+	assert.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		r, err = hasher.Hash(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "fab658db63e94a246188bf7af69a133045f46ee984c56e3c3328caaf1aa1a583", hex.EncodeToString(r))
+	}
+	fork, err := hasher.Clone()
+	assert.Nil(t, err)
+	assert.Equal(t, r, fork.Sum(nil))
+
+	r, err = Sum(KT256, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "b23d2e9cea9f4904e02bec06817fc10ce38ce8e93ef4c89e6537076af8646404e3e8b68107b8833a5d30490aa33482353fd4adc7148ecb782855003aaebde4a9", hex.EncodeToString(r))
+	r, err = Sum(TURBOSHAKE_256, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "367a329dafea871c7802ec67f905ae13c57695dc2c6663c61035f59a18f8e7db11edc0e12e91ea60eb6b32df06dd7f002fbafabb6e13ec1cc20d995547600db0", hex.EncodeToString(r))
+	assert.Equal(t, "TurboSHAKE128", TURBOSHAKE_128.String())
+	assert.Equal(t, 32, NewHasher(TURBOSHAKE_128).Size())
+
+	_, err = NewCustomHasher(SHA3_256, ptn)
+	assert.NotNil(t, err)
+}
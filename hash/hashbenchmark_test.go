@@ -321,3 +321,36 @@ func BenchmarkKeccak256Batch(b *testing.B) {
 		}
 	}
 }
+
+// transaction-sized messages for the multi-message benchmarks
+func manyMessages() [][]byte {
+	msgs := make([][]byte, 4096)
+	for i := range msgs {
+		msgs[i] = []byte(msg[i%400 : i%400+200])
+	}
+	return msgs
+}
+
+func BenchmarkKeccak256Many(b *testing.B) {
+	msgs := manyMessages()
+	b.SetBytes(int64(200 * len(msgs)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if len(HashMany(KECCAK_256, msgs)) != len(msgs) {
+			b.Error("err")
+		}
+	}
+}
+
+func BenchmarkKeccak256ManyLoop(b *testing.B) {
+	msgs := manyMessages()
+	hasher := NewHasher(KECCAK_256)
+	b.SetBytes(int64(200 * len(msgs)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		out := make([][]byte, len(msgs))
+		for j := range msgs {
+			out[j], _ = hasher.Hash(msgs[j])
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package hash
+
+import (
+	"runtime"
+	"sync"
+
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+)
+
+// minMessagesPerWorker keeps tiny batches on the calling goroutine
+const minMessagesPerWorker = 64
+
+//HashMany compute the digest of every message independently, the i-th digest is of msgs[i].
+// It is the opposite of BatchHash, which hashes the concatenation of msgs.
+// The messages are split among worker goroutines using pooled hashers, and the
+// Keccak and SHA3 families permute several sponge states per pass.
+// HashMany is safe for concurrent use and returns nil if hashType is not supported.
+func HashMany(hashType HashType, msgs [][]byte) [][]byte {
+	proto := getHasher(hashType)
+	if proto == nil {
+		return nil
+	}
+	defer putHasher(proto)
+
+	out := make([][]byte, len(msgs))
+	workers := runtime.GOMAXPROCS(0)
+	if n := (len(msgs) + minMessagesPerWorker - 1) / minMessagesPerWorker; n < workers {
+		workers = n
+	}
+	if workers <= 1 {
+		hashRange(proto, msgs, out)
+		return out
+	}
+
+	var wg sync.WaitGroup
+	chunk := (len(msgs) + workers - 1) / workers
+	for start := 0; start < len(msgs); start += chunk {
+		end := start + chunk
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		wg.Add(1)
+		go func(msgs, out [][]byte) {
+			defer wg.Done()
+			h := getHasher(hashType)
+			hashRange(h, msgs, out)
+			putHasher(h)
+		}(msgs[start:end], out[start:end])
+	}
+	wg.Wait()
+	return out
+}
+
+// hashRange writes the digest of msgs[i] to out[i] with h
+func hashRange(h *Hasher, msgs, out [][]byte) {
+	if r := sha3Hash.SumMany(h.inner, msgs); r != nil {
+		copy(out, r)
+		return
+	}
+	size := h.Size()
+	digests := make([]byte, 0, size*len(msgs))
+	for i := range msgs {
+		h.Reset()
+		_, _ = h.inner.Write(msgs[i])
+		digests = h.inner.Sum(digests)
+		out[i] = digests[len(digests)-size : len(digests) : len(digests)]
+	}
+}
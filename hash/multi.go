@@ -0,0 +1,155 @@
+package hash
+
+import (
+	"hash"
+	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// readerChunkSize is the size of the buffers HashReader reads into
+	readerChunkSize = 64 << 10
+	// readerChunks bounds the memory of HashReader to readerChunks * readerChunkSize
+	readerChunks = 4
+)
+
+//MultiHasher compute the digests of several algorithms of the same data in one pass.
+// MultiHasher is not safe for concurrent use.
+type MultiHasher struct {
+	types   []HashType
+	hashers []hash.Hash
+}
+
+//NewMultiHasher return a MultiHasher of types, the digests are returned in the same order.
+// It returns UnsupportedHashError if one of the types is unknown.
+func NewMultiHasher(types ...HashType) (*MultiHasher, error) {
+	m := &MultiHasher{
+		types:   append([]HashType(nil), types...),
+		hashers: make([]hash.Hash, len(types)),
+	}
+	for i, t := range types {
+		if m.hashers[i] = newInner(t); m.hashers[i] == nil {
+			return nil, UnsupportedHashError(t)
+		}
+	}
+	return m, nil
+}
+
+//Write add p to every hash, it never returns an error
+func (m *MultiHasher) Write(p []byte) (int, error) {
+	for _, h := range m.hashers {
+		_, _ = h.Write(p)
+	}
+	return len(p), nil
+}
+
+//Sums return the digests of the data written so far, the i-th digest is of the i-th type.
+// It does not change the underlying states, so more data can be written.
+func (m *MultiHasher) Sums() [][]byte {
+	ret := make([][]byte, len(m.hashers))
+	for i, h := range m.hashers {
+		ret[i] = h.Sum(nil)
+	}
+	return ret
+}
+
+//Sum return the digest of the first hasher of hashType, or nil if there is none
+func (m *MultiHasher) Sum(hashType HashType) []byte {
+	for i, t := range m.types {
+		if t == hashType {
+			return m.hashers[i].Sum(nil)
+		}
+	}
+	return nil
+}
+
+//Types return the hash types in the order of Sums
+func (m *MultiHasher) Types() []HashType {
+	return append([]HashType(nil), m.types...)
+}
+
+//Reset reset every hash to the initial state
+func (m *MultiHasher) Reset() {
+	for _, h := range m.hashers {
+		h.Reset()
+	}
+}
+
+//HashReader read r until EOF and return the digest of every type, the i-th digest is of types[i].
+// At most 256 KiB is buffered whatever the size of the stream. With more than one type and
+// GOMAXPROCS > 1 every hash runs on its own goroutine while the next chunk is being read.
+func HashReader(r io.Reader, types ...HashType) ([][]byte, error) {
+	m, err := NewMultiHasher(types...)
+	if err != nil {
+		return nil, err
+	}
+	if len(m.hashers) < 2 || runtime.GOMAXPROCS(0) < 2 {
+		if _, err := io.CopyBuffer(m, r, make([]byte, readerChunkSize)); err != nil {
+			return nil, err
+		}
+		return m.Sums(), nil
+	}
+	if err := m.readParallel(r); err != nil {
+		return nil, err
+	}
+	return m.Sums(), nil
+}
+
+// chunk is a buffer shared by all the hash goroutines, the last one to
+// finish with it gives it back.
+type chunk struct {
+	data    []byte
+	pending *int32
+}
+
+// readParallel reads r into a ring of readerChunks buffers and feeds every
+// chunk to one goroutine per hash.
+func (m *MultiHasher) readParallel(r io.Reader) error {
+	free := make(chan []byte, readerChunks)
+	for i := 0; i < readerChunks; i++ {
+		free <- make([]byte, readerChunkSize)
+	}
+	queues := make([]chan chunk, len(m.hashers))
+	var wg sync.WaitGroup
+	for i, h := range m.hashers {
+		queues[i] = make(chan chunk, readerChunks)
+		wg.Add(1)
+		go func(h hash.Hash, queue <-chan chunk) {
+			defer wg.Done()
+			for c := range queue {
+				_, _ = h.Write(c.data)
+				if atomic.AddInt32(c.pending, -1) == 0 {
+					free <- c.data[:cap(c.data)]
+				}
+			}
+		}(h, queues[i])
+	}
+
+	var err error
+	for {
+		buf := <-free
+		var n int
+		n, err = r.Read(buf)
+		if n > 0 {
+			pending := int32(len(queues))
+			for _, q := range queues {
+				q <- chunk{data: buf[:n], pending: &pending}
+			}
+		} else {
+			free <- buf
+		}
+		if err != nil {
+			break
+		}
+	}
+	for _, q := range queues {
+		close(q)
+	}
+	wg.Wait()
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package hash
+
+import (
+	"sync"
+)
+
+// hasherPools keeps a sync.Pool of idle Hashers for every HashType
+var hasherPools sync.Map
+
+// getHasher returns a reset Hasher of hashType from the pool, or nil if
+// hashType is not supported.
+func getHasher(hashType HashType) *Hasher {
+	p, ok := hasherPools.Load(hashType)
+	if !ok {
+		if NewHasher(hashType) == nil {
+			return nil
+		}
+		p, _ = hasherPools.LoadOrStore(hashType, &sync.Pool{New: func() interface{} {
+			return NewHasher(hashType)
+		}})
+	}
+	return p.(*sync.Pool).Get().(*Hasher)
+}
+
+// putHasher resets h and gives it back to the pool.
+func putHasher(h *Hasher) {
+	h.Reset()
+	h.dirty = false
+	if p, ok := hasherPools.Load(h.hashType); ok {
+		p.(*sync.Pool).Put(h)
+	}
+}
+
+//Sum return the digest of msg, it takes a Hasher of hashType from a pool
+// so it is safe for concurrent use and does not allocate a Hasher per call.
+func Sum(hashType HashType, msg []byte) ([]byte, error) {
+	h := getHasher(hashType)
+	if h == nil {
+		return nil, UnsupportedHashError(hashType)
+	}
+	defer putHasher(h)
+	return h.Hash(msg)
+}
@@ -0,0 +1,159 @@
+package hash
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"fmt"
+	"hash"
+	"strings"
+	"sync"
+
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+)
+
+// hashInfo is a registered algorithm
+type hashInfo struct {
+	name    string
+	oid     asn1.ObjectIdentifier
+	newHash func() hash.Hash
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = make(map[HashType]*hashInfo)
+	// byName is keyed by normalized names and aliases
+	byName = make(map[string]HashType)
+	// byOID is keyed by the dotted form of OIDs
+	byOID = make(map[string]HashType)
+)
+
+func init() {
+	builtins := []struct {
+		hashType HashType
+		name     string
+		oid      asn1.ObjectIdentifier
+		newHash  func() hash.Hash
+		aliases  []string
+	}{
+		{SHA1, "SHA1", asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}, sha1.New, []string{"SHA-1"}},
+		{SHA2_224, "SHA2-224", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 4}, sha256.New224, []string{"SHA-224"}},
+		{SHA2_256, "SHA2-256", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}, sha256.New, []string{"SHA-256"}},
+		{SHA2_384, "SHA2-384", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}, sha512.New384, []string{"SHA-384"}},
+		{SHA2_512, "SHA2-512", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}, sha512.New, []string{"SHA-512"}},
+		{SHA3_224, "SHA3-224", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 7}, sha3Hash.New224, nil},
+		{SHA3_256, "SHA3-256", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 8}, sha3Hash.New256, nil},
+		{SHA3_384, "SHA3-384", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 9}, sha3Hash.New384, nil},
+		{SHA3_512, "SHA3-512", asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 10}, sha3Hash.New512, nil},
+		// the original Keccak padding has no OID
+		{KECCAK_224, "KECCAK-224", nil, sha3Hash.NewKeccak224, nil},
+		{KECCAK_256, "KECCAK-256", nil, sha3Hash.NewKeccak256, nil},
+		{KECCAK_384, "KECCAK-384", nil, sha3Hash.NewKeccak384, nil},
+		{KECCAK_512, "KECCAK-512", nil, sha3Hash.NewKeccak512, nil},
+		// RFC 9861 assigns no OID, TurboSHAKE uses the default domain byte 0x1F
+		{KT128, "KT128", nil, func() hash.Hash { return sha3Hash.NewKT128(nil) }, []string{"KangarooTwelve", "K12"}},
+		{KT256, "KT256", nil, func() hash.Hash { return sha3Hash.NewKT256(nil) }, nil},
+		{TURBOSHAKE_128, "TurboSHAKE128", nil, func() hash.Hash { return sha3Hash.NewTurboShake128(0x1f) }, nil},
+		{TURBOSHAKE_256, "TurboSHAKE256", nil, func() hash.Hash { return sha3Hash.NewTurboShake256(0x1f) }, nil},
+	}
+	for _, b := range builtins {
+		if err := RegisterHash(b.hashType, b.name, b.oid, b.newHash); err != nil {
+			panic(err)
+		}
+		for _, alias := range b.aliases {
+			byName[normalizeName(alias)] = b.hashType
+		}
+	}
+}
+
+// normalizeName makes names case-insensitive and ignores '-' and '_',
+// so "SHA3-256", "sha3_256" and "Sha3256" are the same name.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(name))
+}
+
+//RegisterHash make an algorithm available to NewHasher, New, Sum, HashMany and ParseHashType.
+// name is matched case-insensitively ignoring '-' and '_', oid may be nil if the algorithm has none.
+// It returns an error if hashType, name or oid is already registered, so the built-in algorithms
+// can not be replaced. RegisterHash is usually called from an init function.
+func RegisterHash(hashType HashType, name string, oid asn1.ObjectIdentifier, newHash func() hash.Hash) error {
+	if name == "" || newHash == nil {
+		return fmt.Errorf("hash: invalid registration of hash type 0x%x", uint32(hashType))
+	}
+	key := normalizeName(name)
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if _, ok := registry[hashType]; ok {
+		return fmt.Errorf("hash: hash type 0x%x is already registered", uint32(hashType))
+	}
+	if _, ok := byName[key]; ok {
+		return fmt.Errorf("hash: hash name %q is already registered", name)
+	}
+	if len(oid) != 0 {
+		if _, ok := byOID[oid.String()]; ok {
+			return fmt.Errorf("hash: hash oid %v is already registered", oid)
+		}
+		byOID[oid.String()] = hashType
+	}
+	registry[hashType] = &hashInfo{name: name, oid: append(asn1.ObjectIdentifier(nil), oid...), newHash: newHash}
+	byName[key] = hashType
+	return nil
+}
+
+// lookup returns the registered algorithm of hashType or nil
+func lookup(hashType HashType) *hashInfo {
+	registryLock.RLock()
+	info := registry[hashType]
+	registryLock.RUnlock()
+	return info
+}
+
+//ParseHashType return the hash type registered with name or one of its aliases,
+// e.g. "SHA3-256", "sha-256" or "keccak256".
+func ParseHashType(name string) (HashType, error) {
+	registryLock.RLock()
+	hashType, ok := byName[normalizeName(name)]
+	registryLock.RUnlock()
+	if !ok {
+		return 0, fmt.Errorf("hash: unknown hash name %q", name)
+	}
+	return hashType, nil
+}
+
+//HashTypeFromOID return the hash type registered with oid
+func HashTypeFromOID(oid asn1.ObjectIdentifier) (HashType, error) {
+	registryLock.RLock()
+	hashType, ok := byOID[oid.String()]
+	registryLock.RUnlock()
+	if !ok {
+		return 0, fmt.Errorf("hash: unknown hash oid %v", oid)
+	}
+	return hashType, nil
+}
+
+//String return the registered name, e.g. "SHA3-256"
+func (t HashType) String() string {
+	if info := lookup(t); info != nil {
+		return info.name
+	}
+	return fmt.Sprintf("HashType(0x%x)", uint32(t))
+}
+
+//OID return the registered ASN.1 object identifier, or nil if there is none
+func (t HashType) OID() asn1.ObjectIdentifier {
+	if info := lookup(t); info != nil && len(info.oid) != 0 {
+		return append(asn1.ObjectIdentifier(nil), info.oid...)
+	}
+	return nil
+}
+
+//Available report whether hashType is registered
+func (t HashType) Available() bool {
+	return lookup(t) != nil
+}
@@ -0,0 +1,206 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+
+// Command _asm generates keccakf_amd64.s, the Keccak-f[1600] permutations for
+// amd64: a BMI2 loop of two rounds for one state and the unrolled AVX2
+// permutation of four states. Every round reads the state from one buffer and
+// writes the result to the other, so θ, ρ, π, χ and ι of a round are done in
+// a single pass without in-place shuffling.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+var rc = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// rotation offsets of ρ, indexed by x+5y
+var rotc = [25]uint{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// source returns the index of the lane that π moves to position x+5y.
+func source(x, y int) int {
+	return (x+3*y)%5 + 5*x
+}
+
+var out bytes.Buffer
+
+func p(format string, args ...interface{}) {
+	fmt.Fprintf(&out, format, args...)
+	out.WriteByte('\n')
+}
+
+// genBMI2 emits keccakP1600BMI2, which applies the last rounds rounds of the
+// permutation with BMI2. The state is in memory at DI and the temporary buffer
+// is the local frame, every iteration of the loop does two rounds, from DI to
+// the frame and back, so rounds must be even. SI is the offset of the round
+// constant in rc4<>.
+func genBMI2() {
+	c := []string{"R8", "R9", "R10", "R11", "R12"}
+	d := []string{"R13", "R14", "R15", "AX", "BX"}
+	b := c // the column parities are dead once D is computed
+	mem := func(buf, i int) string {
+		if buf == 0 {
+			return fmt.Sprintf("%d(DI)", i*8)
+		}
+		return fmt.Sprintf("%d(SP)", i*8)
+	}
+
+	p("// func keccakP1600BMI2(a *[25]uint64, rounds int)")
+	p("TEXT ·keccakP1600BMI2(SB), NOSPLIT, $200-16")
+	p("\tMOVQ a+0(FP), DI")
+	p("\tMOVQ $24, SI")
+	p("\tSUBQ rounds+8(FP), SI")
+	p("\tSHLQ $5, SI")
+	p("\tLEAQ rc4<>(SB), DX")
+	p("")
+	p("loop:")
+	for src := 0; src < 2; src++ {
+		dst := 1 - src
+		for x := 0; x < 5; x++ {
+			p("\tMOVQ %s, %s", mem(src, x), c[x])
+			for y := 1; y < 5; y++ {
+				p("\tXORQ %s, %s", mem(src, x+5*y), c[x])
+			}
+		}
+		for x := 0; x < 5; x++ {
+			p("\tRORXQ $63, %s, %s", c[(x+1)%5], d[x])
+			p("\tXORQ %s, %s", c[(x+4)%5], d[x])
+		}
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				i := source(x, y)
+				p("\tMOVQ %s, %s", mem(src, i), b[x])
+				p("\tXORQ %s, %s", d[i%5], b[x])
+				if rotc[i] != 0 {
+					p("\tRORXQ $%d, %s, %s", 64-rotc[i], b[x], b[x])
+				}
+			}
+			for x := 0; x < 5; x++ {
+				p("\tANDNQ %s, %s, CX", b[(x+2)%5], b[(x+1)%5])
+				p("\tXORQ %s, CX", b[x])
+				if x == 0 && y == 0 {
+					p("\tXORQ (DX)(SI*1), CX")
+				}
+				p("\tMOVQ CX, %s", mem(dst, x+5*y))
+			}
+		}
+		p("\tADDQ $32, SI")
+		p("")
+	}
+	p("\tCMPQ SI, $%d", 24*32)
+	p("\tJB loop")
+	p("\tRET")
+}
+
+// genAVX2 emits keccakF1600x4AVX2. The four states are transposed into the
+// local frame so that lane i of every state shares a YMM word, the second
+// half of the frame is the temporary buffer.
+func genAVX2() {
+	c := []string{"Y0", "Y1", "Y2", "Y3", "Y4"}
+	d := []string{"Y5", "Y6", "Y7", "Y8", "Y9"}
+	b := []string{"Y10", "Y11", "Y12", "Y13", "Y14"}
+	const t = "Y15"
+	mem := func(buf, i int) string {
+		return fmt.Sprintf("%d(SP)", buf*800+i*32)
+	}
+
+	p("// func keccakF1600x4AVX2(a *[4][25]uint64)")
+	p("TEXT ·keccakF1600x4AVX2(SB), 0, $1600-8")
+	p("\tMOVQ a+0(FP), DI")
+	for i := 0; i < 25; i++ {
+		for l := 0; l < 4; l++ {
+			p("\tMOVQ %d(DI), AX", l*200+i*8)
+			p("\tMOVQ AX, %d(SP)", i*32+l*8)
+		}
+	}
+	for r := 0; r < 24; r++ {
+		src, dst := r&1, 1-r&1
+		p("")
+		p("\t// round %d", r)
+		for x := 0; x < 5; x++ {
+			p("\tVMOVDQU %s, %s", mem(src, x), c[x])
+			for y := 1; y < 5; y++ {
+				p("\tVPXOR %s, %s, %s", mem(src, x+5*y), c[x], c[x])
+			}
+		}
+		for x := 0; x < 5; x++ {
+			p("\tVPSLLQ $1, %s, %s", c[(x+1)%5], t)
+			p("\tVPSRLQ $63, %s, %s", c[(x+1)%5], d[x])
+			p("\tVPOR %s, %s, %s", t, d[x], d[x])
+			p("\tVPXOR %s, %s, %s", c[(x+4)%5], d[x], d[x])
+		}
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				i := source(x, y)
+				p("\tVPXOR %s, %s, %s", mem(src, i), d[i%5], b[x])
+				if rotc[i] != 0 {
+					p("\tVPSLLQ $%d, %s, %s", rotc[i], b[x], t)
+					p("\tVPSRLQ $%d, %s, %s", 64-rotc[i], b[x], b[x])
+					p("\tVPOR %s, %s, %s", t, b[x], b[x])
+				}
+			}
+			for x := 0; x < 5; x++ {
+				p("\tVPANDN %s, %s, %s", b[(x+2)%5], b[(x+1)%5], t)
+				p("\tVPXOR %s, %s, %s", b[x], t, t)
+				if x == 0 && y == 0 {
+					p("\tVPXOR rc4<>+%d(SB), %s, %s", r*32, t, t)
+				}
+				p("\tVMOVDQU %s, %s", t, mem(dst, x+5*y))
+			}
+		}
+	}
+	p("")
+	for i := 0; i < 25; i++ {
+		for l := 0; l < 4; l++ {
+			p("\tMOVQ %d(SP), AX", i*32+l*8)
+			p("\tMOVQ AX, %d(DI)", l*200+i*8)
+		}
+	}
+	p("\tVZEROUPPER")
+	p("\tRET")
+}
+
+func main() {
+	output := flag.String("out", "keccakf_amd64.s", "output file")
+	flag.Parse()
+
+	p("// Code generated by go run ./_asm. DO NOT EDIT.")
+	p("")
+	p("//go:build amd64 && !purego")
+	p("// +build amd64,!purego")
+	p("")
+	p("#include \"textflag.h\"")
+	p("")
+	p("// round constants of ι broadcast to the 4 lanes of a YMM word")
+	for r := 0; r < 24; r++ {
+		for l := 0; l < 4; l++ {
+			p("DATA rc4<>+%d(SB)/8, $0x%016x", r*32+l*8, rc[r])
+		}
+	}
+	p("GLOBL rc4<>(SB), RODATA|NOPTR, $%d", 24*32)
+	p("")
+	genBMI2()
+	p("")
+	genAVX2()
+
+	if err := ioutil.WriteFile(*output, out.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
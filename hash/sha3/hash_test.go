@@ -1,8 +1,10 @@
 package sha3
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"github.com/stretchr/testify/assert"
+	"hash"
 	"testing"
 )
 
@@ -88,3 +90,154 @@ func TestSHA3_512(t *testing.T) {
 	hashHex := hex.EncodeToString(hash)
 	assert.Equal(t, sha3_512Expect, hashHex)
 }
+
+func TestMarshalBinary(t *testing.T) {
+	d := NewKeccak256().(*state)
+	_, _ = d.Write([]byte(msg[:200]))
+	b, err := d.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, marshaledSize, len(b))
+
+	restored := NewKeccak256().(*state)
+	assert.Nil(t, restored.UnmarshalBinary(b))
+	_, _ = restored.Write([]byte(msg[200:]))
+	assert.Equal(t, keccak256Expect, hex.EncodeToString(restored.Sum(nil)))
+
+	// a squeezing sponge keeps its output position
+	out := make([]byte, 300)
+	_, _ = d.Read(out[:10])
+	b, err = d.MarshalBinary()
+	assert.Nil(t, err)
+	restored = NewKeccak256().(*state)
+	assert.Nil(t, restored.UnmarshalBinary(b))
+	_, _ = d.Read(out[10:])
+	rest := make([]byte, 290)
+	_, _ = restored.Read(rest)
+	assert.Equal(t, out[10:], rest)
+
+	assert.NotNil(t, New256().(*state).UnmarshalBinary(b))
+	assert.NotNil(t, restored.UnmarshalBinary(b[:10]))
+}
+
+func TestClone(t *testing.T) {
+	d := New256()
+	_, _ = d.Write([]byte(msg[:100]))
+	c := d.(*state).Clone()
+	_, _ = d.Write([]byte("x"))
+	_, _ = c.Write([]byte(msg[100:]))
+	assert.Equal(t, sha3_256Expect, hex.EncodeToString(c.Sum(nil)))
+}
+
+func TestSumAppend(t *testing.T) {
+	d := New256()
+	_, _ = d.Write([]byte(msg))
+	prefix := []byte("prefix")
+	out := d.Sum(prefix)
+	assert.Equal(t, "prefix", string(out[:len(prefix)]))
+	assert.Equal(t, sha3_256Expect, hex.EncodeToString(out[len(prefix):]))
+
+	buf := make([]byte, 1, 64)
+	out = d.Sum(buf)
+	assert.Equal(t, &buf[0], &out[0])
+	assert.Equal(t, sha3_256Expect, hex.EncodeToString(out[1:]))
+}
+
+func TestSumMany(t *testing.T) {
+	var msgs [][]byte
+	for i := 0; i < len(msg); i += 37 {
+		msgs = append(msgs, []byte(msg[:i]))
+	}
+	msgs = append(msgs, []byte(msg[:136]), []byte(msg[:272]), nil)
+	for _, newHash := range []func() hash.Hash{NewKeccak224, NewKeccak256, NewKeccak384, NewKeccak512, New224, New256, New384, New512} {
+		digests := SumMany(newHash(), msgs)
+		for i := range msgs {
+			h := newHash()
+			_, _ = h.Write(msgs[i])
+			assert.Equal(t, h.Sum(nil), digests[i])
+		}
+	}
+	assert.Nil(t, SumMany(sha256.New(), msgs))
+}
+
+func TestKeccakF1600x4(t *testing.T) {
+	var a [lanes][25]uint64
+	var expect [lanes][25]uint64
+	for l := range a {
+		for i := range a[l] {
+			a[l][i] = uint64(l*1000+i) * 0x9e3779b97f4a7c15
+		}
+		expect[l] = a[l]
+		keccakF1600(&expect[l])
+	}
+	keccakF1600x4(&a)
+	assert.Equal(t, expect, a)
+}
+
+func TestKeccakF1600Generic(t *testing.T) {
+	var a, expect [25]uint64
+	x := uint64(0x0123456789abcdef)
+	for n := 0; n < 100; n++ {
+		for i := range a {
+			// xorshift, any fixed sequence will do
+			x ^= x << 13
+			x ^= x >> 7
+			x ^= x << 17
+			a[i] = x
+		}
+		expect = a
+		keccakF1600Generic(&expect)
+		keccakF1600(&a)
+		assert.Equal(t, expect, a)
+	}
+
+	var s, expect4 [lanes][25]uint64
+	for l := range s {
+		s[l] = a
+		s[l][l] ^= uint64(l + 1)
+	}
+	expect4 = s
+	keccakF1600x4Generic(&expect4)
+	keccakF1600x4(&s)
+	assert.Equal(t, expect4, s)
+}
+
+func BenchmarkKeccakF1600(b *testing.B) {
+	var a [25]uint64
+	for i := 0; i < b.N; i++ {
+		keccakF1600(&a)
+	}
+}
+
+func BenchmarkKeccakF1600Generic(b *testing.B) {
+	var a [25]uint64
+	for i := 0; i < b.N; i++ {
+		keccakF1600Generic(&a)
+	}
+}
+
+func BenchmarkKeccakF1600x4(b *testing.B) {
+	var a [lanes][25]uint64
+	for i := 0; i < b.N; i++ {
+		keccakF1600x4(&a)
+	}
+}
+
+func BenchmarkKeccakF1600x4Generic(b *testing.B) {
+	var a [lanes][25]uint64
+	for i := 0; i < b.N; i++ {
+		keccakF1600x4Generic(&a)
+	}
+}
+
+func TestShake(t *testing.T) {
+	// long enough to squeeze more than one SHAKE128 block
+	out := make([]byte, 200)
+	ShakeSum128(out, []byte("abc"))
+	assert.Equal(t, "5881092dd818bf5cf8a3ddb793fbcba74097d5c526a6d35f97b83351940f2cc844c50af32acd3f2cdd066568706f509bc1bdde58295dae3f891a9a0fca5783789a41f8611214ce612394df286a62d1a2252aa94db9c538956c717dc2bed4f232a0294c857c730aa16067ac1062f1201fb0d377cfb9cde4c63599b27f3462bba4a0ed296c801f9ff7f57302bb3076ee145f97a32ae68e76ab66c48d51675bd49acc29082f5647584e6aa01b3f5af057805f973ff8ecb8b226ac32ada6f01c1fcd4818cb006aa5b4cd", hex.EncodeToString(out))
+
+	h := NewShake256()
+	out = make([]byte, 40)
+	_, _ = h.Read(out[:7])
+	_, _ = h.Read(out[7:])
+	assert.Equal(t, "46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762fd75dc4ddd8c0f200", hex.EncodeToString(out))
+}
@@ -0,0 +1,199 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+package sha3
+
+import (
+	"hash"
+	"runtime"
+	"sync"
+)
+
+// chunkSize is the size of the leaves of the KangarooTwelve tree
+const chunkSize = 8192
+
+// leavesPerWorker is the number of leaves a goroutine hashes in one batch,
+// Write buffers leavesPerWorker leaves per CPU at the creation of the hash
+// before hashing them.
+const leavesPerWorker = 8
+
+// kangaroo is a KangarooTwelve instance of RFC 9861. The message is buffered
+// until it is longer than one chunk, then the first chunk S_0 is absorbed by
+// the final node and the following full chunks are hashed into chaining values
+// by parallel goroutines, batch by batch.
+type kangaroo struct {
+	custom []byte
+	// newTurbo returns the TurboSHAKE of the instance, cvLen is the size of
+	// the chaining values and of Sum
+	newTurbo func(domain byte) *state
+	cvLen    int
+	// batch is the number of bytes buffered before the leaves are hashed
+	batch int
+
+	buf []byte
+	// final is nil while the whole input fits in S_0, leaves counts the
+	// chaining values absorbed by final
+	final  *state
+	leaves uint64
+	// out is the sponge being squeezed after the first Read
+	out *state
+}
+
+// NewKT128 creates a KangarooTwelve hash KT128 of RFC 9861 with the
+// customization string custom, which may be nil. Sum returns 32 bytes.
+// Long messages are hashed by several goroutines.
+func NewKT128(custom []byte) ShakeHash {
+	return newKangaroo(custom, 32, func(domain byte) *state { return newTurboShake(168, 32, domain) })
+}
+
+// NewKT256 creates a KangarooTwelve hash KT256 of RFC 9861, see NewKT128.
+// Sum returns 64 bytes.
+func NewKT256(custom []byte) ShakeHash {
+	return newKangaroo(custom, 64, func(domain byte) *state { return newTurboShake(136, 64, domain) })
+}
+
+func newKangaroo(custom []byte, cvLen int, newTurbo func(domain byte) *state) *kangaroo {
+	return &kangaroo{
+		custom:   append([]byte(nil), custom...),
+		newTurbo: newTurbo,
+		cvLen:    cvLen,
+		batch:    leavesPerWorker * runtime.GOMAXPROCS(0) * chunkSize,
+	}
+}
+
+// BlockSize returns the size of the leaves.
+func (k *kangaroo) BlockSize() int { return chunkSize }
+
+// Size returns the output size of Sum in bytes.
+func (k *kangaroo) Size() int { return k.cvLen }
+
+// Reset clears the message, the customization string is kept.
+func (k *kangaroo) Reset() {
+	k.buf, k.final, k.out, k.leaves = k.buf[:0], nil, nil, 0
+}
+
+// Clone returns a copy of the hash in its current state.
+func (k *kangaroo) Clone() hash.Hash { return k.clone() }
+
+func (k *kangaroo) clone() *kangaroo {
+	ret := *k
+	ret.buf = append([]byte(nil), k.buf...)
+	if k.final != nil {
+		ret.final = k.final.clone()
+	}
+	if k.out != nil {
+		ret.out = k.out.clone()
+	}
+	return &ret
+}
+
+// Write absorbs more data, it panics if it is called after Read.
+func (k *kangaroo) Write(p []byte) (written int, err error) {
+	if k.out != nil {
+		panic("sha3: write to sponge after read")
+	}
+	written = len(p)
+	for len(p) > 0 {
+		todo := k.batch - len(k.buf)
+		if todo > len(p) {
+			todo = len(p)
+		}
+		k.buf = append(k.buf, p[:todo]...)
+		p = p[todo:]
+		if len(k.buf) == k.batch {
+			k.flush(false)
+		}
+	}
+	return
+}
+
+// flush starts the tree once S_0 is complete and absorbs the chaining values
+// of the buffered leaves. A partial leaf stays in the buffer unless last is set.
+func (k *kangaroo) flush(last bool) {
+	if k.final == nil {
+		// the message and the customization make S, it needs a tree if it
+		// is longer than one chunk
+		if len(k.buf) < chunkSize || last && len(k.buf) == chunkSize {
+			return
+		}
+		// S_0 || 110^62
+		k.final = k.newTurbo(0x06)
+		_, _ = k.final.Write(k.buf[:chunkSize])
+		_, _ = k.final.Write([]byte{0x03, 0, 0, 0, 0, 0, 0, 0})
+		k.buf = k.buf[chunkSize:]
+	}
+	n := len(k.buf) / chunkSize
+	if last && len(k.buf)%chunkSize != 0 {
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	cvs := make([]byte, n*k.cvLen)
+	workers := (n + leavesPerWorker - 1) / leavesPerWorker
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start, end := w*leavesPerWorker, (w+1)*leavesPerWorker
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			leaf := k.newTurbo(0x0b)
+			for i := start; i < end; i++ {
+				msg := k.buf[i*chunkSize:]
+				if len(msg) > chunkSize {
+					msg = msg[:chunkSize]
+				}
+				leaf.Reset()
+				_, _ = leaf.Write(msg)
+				_, _ = leaf.Read(cvs[i*k.cvLen : (i+1)*k.cvLen])
+			}
+		}(start, end)
+	}
+	wg.Wait()
+	_, _ = k.final.Write(cvs)
+	k.leaves += uint64(n)
+	if rest := n * chunkSize; rest < len(k.buf) {
+		k.buf = append(k.buf[:0], k.buf[rest:]...)
+	} else {
+		k.buf = k.buf[:0]
+	}
+}
+
+// Read squeezes an arbitrary number of bytes of output.
+func (k *kangaroo) Read(out []byte) (n int, err error) {
+	if k.out == nil {
+		k.buf = append(k.buf, k.custom...)
+		k.buf = append(k.buf, lengthEncode(uint64(len(k.custom)))...)
+		k.flush(true)
+		if k.final == nil {
+			k.out = k.newTurbo(0x07)
+			_, _ = k.out.Write(k.buf)
+		} else {
+			k.out = k.final
+			_, _ = k.out.Write(lengthEncode(k.leaves))
+			_, _ = k.out.Write([]byte{0xff, 0xff})
+		}
+		k.buf, k.final = k.buf[:0], nil
+	}
+	return k.out.Read(out)
+}
+
+// Sum appends Size() bytes of output to in without changing the state.
+func (k *kangaroo) Sum(in []byte) []byte {
+	dup := k.clone()
+	ret := append(in, make([]byte, k.cvLen)...)
+	_, _ = dup.Read(ret[len(in):])
+	return ret
+}
+
+// lengthEncode returns length_encode(x) of RFC 9861, the big-endian bytes of
+// x without leading zeros followed by their count.
+func lengthEncode(x uint64) []byte {
+	var ret []byte
+	for ; x > 0; x >>= 8 {
+		ret = append([]byte{byte(x)}, ret...)
+	}
+	return append(ret, byte(len(ret)))
+}
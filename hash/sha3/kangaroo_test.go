@@ -0,0 +1,239 @@
+package sha3
+
+import (
+	"encoding/hex"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ptn is the pattern message of the RFC 9861 test vectors
+func ptn(n int) []byte {
+	ret := make([]byte, n)
+	for i := range ret {
+		ret[i] = byte(i % 0xfb)
+	}
+	return ret
+}
+
+func pow(x, y int) int {
+	ret := 1
+	for ; y > 0; y-- {
+		ret *= x
+	}
+	return ret
+}
+
+func TestTurboShake(t *testing.T) {
+	out := make([]byte, 10032)
+	h := NewTurboShake128(0x07)
+	_, _ = h.Read(out)
+	assert.Equal(t, "5a223ad30b3b8c66a243048cfced430f54e7529287d15150b973133adfac6a2ffe2708e73061e09a4000168ba9c8ca1813198f7bbed4984b4185f2c2580ee623", hex.EncodeToString(out[:64]))
+	assert.Equal(t, "7593a28020a3c4ae0d605fd61f5eb56eccd27cc3d12ff09f78369772a460c55d", hex.EncodeToString(out[10000:]))
+
+	h = NewTurboShake128(0x06)
+	_, _ = h.Write([]byte{0xff})
+	assert.Equal(t, "8ec9c66465ed0d4a6c35d13506718d687a25cb05c74cca1e42501abd83874a67", hex.EncodeToString(h.Sum(nil)))
+
+	h = NewTurboShake256(0x1f)
+	assert.Equal(t, "367a329dafea871c7802ec67f905ae13c57695dc2c6663c61035f59a18f8e7db11edc0e12e91ea60eb6b32df06dd7f002fbafabb6e13ec1cc20d995547600db0", hex.EncodeToString(h.Sum(nil)))
+
+	// the state of TurboSHAKE is not the one of SHAKE
+	h = NewTurboShake128(0x1f)
+	_, _ = h.Write([]byte(msg))
+	saved, err := h.(*state).MarshalBinary()
+	assert.Nil(t, err)
+	assert.NotNil(t, NewShake128().(*state).UnmarshalBinary(saved))
+	restored := NewTurboShake128(0x1f)
+	assert.Nil(t, restored.(*state).UnmarshalBinary(saved))
+	assert.Equal(t, h.Sum(nil), restored.Sum(nil))
+
+	assert.Panics(t, func() { NewTurboShake128(0) })
+	assert.Panics(t, func() { NewTurboShake256(0x80) })
+}
+
+func TestKT128(t *testing.T) {
+	cases := []struct {
+		msg, custom []byte
+		size        int
+		expect      string
+	}{
+		{nil, nil, 32, "1ac2d450fc3b4205d19da7bfca1b37513c0803577ac7167f06fe2ce1f0ef39e5"},
+		{ptn(17), nil, 32, "6bf75fa2239198db4772e36478f8e19b0f371205f6a9a93a273f51df37122888"},
+		{ptn(pow(17, 2)), nil, 32, "0c315ebcdedbf61426de7dcf8fb725d1e74675d7f5327a5067f367b108ecb67c"},
+		{ptn(pow(17, 3)), nil, 32, "cb552e2ec77d9910701d578b457ddf772c12e322e4ee7fe417f92c758f0d59d0"},
+		{ptn(pow(17, 4)), nil, 32, "8701045e22205345ff4dda05555cbb5c3af1a771c2b89baef37db43d9998b9fe"},
+		{ptn(pow(17, 5)), nil, 32, "844d610933b1b9963cbdeb5ae3b6b05cc7cbd67ceedf883eb678a0a8e0371682"},
+		{ptn(pow(17, 6)), nil, 32, "3c390782a8a4e89fa6367f72feaaf13255c8d95878481d3cd8ce85f58e880af8"},
+		{nil, ptn(1), 32, "fab658db63e94a246188bf7af69a133045f46ee984c56e3c3328caaf1aa1a583"},
+		{[]byte{0xff}, ptn(41), 32, "d848c5068ced736f4462159b9867fd4c20b808acc3d5bc48e0b06ba0a3762ec4"},
+		{[]byte{0xff, 0xff, 0xff}, ptn(pow(41, 2)), 32, "c389e5009ae57120854c2e8c64670ac01358cf4c1baf89447a724234dc7ced74"},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, ptn(pow(41, 3)), 32, "75d2f86a2e644566726b4fbcfc5657b9dbcf070c7b0dca06450ab291d7443bcf"},
+		{ptn(chunkSize), nil, 16, "48f256f6772f9edfb6a8b661ec92dc93"},
+		{ptn(chunkSize + 1), nil, 16, "bb66fe72eaea5179418d5295ee134485"},
+		{ptn(2 * chunkSize), nil, 16, "82778f7f7234c83352e76837b721fbdb"},
+		{ptn(2*chunkSize + 1), nil, 16, "5f8d2b943922b451842b4e82740d0236"},
+		{ptn(3 * chunkSize), nil, 16, "f4082a8fe7d1635aa042cd1da63bf235"},
+		{ptn(3*chunkSize + 1), nil, 16, "38cb940999aca742d69dd79298c6051c"},
+	}
+	for _, c := range cases {
+		// uneven writes cross the chunks and the batches at different offsets
+		for _, writeSize := range []int{1 << 30, 4093, chunkSize} {
+			if writeSize < 1<<30 && len(c.msg) > 1<<20 {
+				continue
+			}
+			h := NewKT128(c.custom)
+			for m := c.msg; len(m) > 0; {
+				n := writeSize
+				if n > len(m) {
+					n = len(m)
+				}
+				_, _ = h.Write(m[:n])
+				m = m[n:]
+			}
+			out := make([]byte, c.size)
+			_, _ = h.Read(out)
+			assert.Equal(t, c.expect, hex.EncodeToString(out), "%d bytes", len(c.msg))
+		}
+	}
+}
+
+func TestKT256(t *testing.T) {
+	cases := []struct {
+		msg, custom []byte
+		size        int
+		expect      string
+	}{
+		{nil, nil, 64, "b23d2e9cea9f4904e02bec06817fc10ce38ce8e93ef4c89e6537076af8646404e3e8b68107b8833a5d30490aa33482353fd4adc7148ecb782855003aaebde4a9"},
+		{ptn(17), nil, 64, "1ba3c02b1fc514474f06c8979978a9056c8483f4a1b63d0dccefe3a28a2f323e1cdcca40ebf006ac76ef0397152346837b1277d3e7faa9c9653b19075098527b"},
+		{ptn(pow(17, 3)), nil, 64, "647efb49fe9d717500171b41e7f11bd491544443209997ce1c2530d15eb1ffbb598935ef954528ffc152b1e4d731ee2683680674365cd191d562bae753b84aa5"},
+		{[]byte{0xff}, ptn(41), 64, "47ef96dd616f200937aa7847e34ec2feae8087e3761dc0f8c1a154f51dc9ccf845d7adbce57ff64b639722c6a1672e3bf5372d87e00aff89be97240756998853"},
+		{ptn(3*chunkSize + 1), nil, 32, "7550050c27c81f7fe9b0b00cf66fd5ecea51edd449f1ca83e3eab024234471f5"},
+	}
+	for _, c := range cases {
+		h := NewKT256(c.custom)
+		_, _ = h.Write(c.msg)
+		out := make([]byte, c.size)
+		_, _ = h.Read(out)
+		assert.Equal(t, c.expect, hex.EncodeToString(out), "%d bytes", len(c.msg))
+	}
+}
+
+func TestKTSum(t *testing.T) {
+	h := NewKT128([]byte("custom"))
+	_, _ = h.Write(ptn(5 * chunkSize))
+	sum := h.Sum([]byte{1})
+	assert.Equal(t, 33, len(sum))
+	assert.Equal(t, byte(1), sum[0])
+
+	// Sum does not change the state and a clone continues independently
+	dup := h.(*kangaroo).Clone()
+	_, _ = h.Write([]byte("more"))
+	assert.Equal(t, sum[1:], dup.Sum(nil))
+	more := h.Sum(nil)
+	assert.NotEqual(t, sum[1:], more)
+
+	out := make([]byte, 64)
+	_, _ = h.Read(out)
+	assert.Equal(t, more, out[:32])
+	assert.Panics(t, func() { _, _ = h.Write([]byte{0}) })
+
+	h.Reset()
+	_, _ = h.Write(ptn(5 * chunkSize))
+	assert.Equal(t, sum[1:], h.Sum(nil))
+	assert.NotEqual(t, sum[1:], NewKT128(nil).Sum(nil))
+
+	// the batch size of a hash does not follow later changes of GOMAXPROCS
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	msg := ptn(20 * chunkSize)
+	h = NewKT128(nil)
+	_, _ = h.Write(msg[:10*chunkSize])
+	runtime.GOMAXPROCS(1)
+	_, _ = h.Write(msg[10*chunkSize:])
+	expect := NewKT128(nil)
+	_, _ = expect.Write(msg)
+	assert.Equal(t, expect.Sum(nil), h.Sum(nil))
+}
+
+func TestKangarooMarshalBinary(t *testing.T) {
+	msg := ptn(20*chunkSize + 100)
+	for _, newKT := range []func([]byte) ShakeHash{NewKT128, NewKT256} {
+		h := newKT([]byte("custom"))
+		_, _ = h.Write(msg)
+		expect := make([]byte, 200)
+		_, _ = h.Read(expect)
+
+		// before S_0 is complete, in the tree and in the middle of a leaf
+		for _, split := range []int{0, 100, chunkSize, chunkSize + 1, 3*chunkSize + 5, len(msg)} {
+			h = newKT([]byte("custom"))
+			_, _ = h.Write(msg[:split])
+			saved, err := h.(*kangaroo).MarshalBinary()
+			assert.Nil(t, err)
+			assert.True(t, len(saved) < chunkSize+1000)
+
+			restored := newKT(nil)
+			assert.Nil(t, restored.(*kangaroo).UnmarshalBinary(saved))
+			_, _ = restored.Write(msg[split:])
+			out := make([]byte, 200)
+			_, _ = restored.Read(out)
+			assert.Equal(t, expect, out, "split %d", split)
+		}
+
+		// a squeezing hash keeps its output position, for a single chunk too
+		for _, m := range [][]byte{msg, msg[:10]} {
+			h = newKT(nil)
+			_, _ = h.Write(m)
+			out := make([]byte, 200)
+			_, _ = h.Read(out[:10])
+			saved, err := h.(*kangaroo).MarshalBinary()
+			assert.Nil(t, err)
+			restored := newKT(nil)
+			assert.Nil(t, restored.(*kangaroo).UnmarshalBinary(saved))
+			_, _ = h.Read(out[10:])
+			rest := make([]byte, 190)
+			_, _ = restored.Read(rest)
+			assert.Equal(t, out[10:], rest)
+		}
+	}
+
+	saved, err := NewKT128(nil).(*kangaroo).MarshalBinary()
+	assert.Nil(t, err)
+	assert.NotNil(t, NewKT256(nil).(*kangaroo).UnmarshalBinary(saved))
+	assert.NotNil(t, NewKT128(nil).(*kangaroo).UnmarshalBinary(saved[:10]))
+	assert.NotNil(t, NewKT128(nil).(*kangaroo).UnmarshalBinary(append(saved, 0)))
+}
+
+func TestKeccakP12(t *testing.T) {
+	var a, b [25]uint64
+	for i := range a {
+		a[i] = uint64(i) * 0x0123456789abcdef
+	}
+	b = a
+	c := a
+	keccakP12(&a)
+	keccakP1600Generic(&b, 12)
+	assert.Equal(t, b, a)
+	keccakF1600(&c)
+	assert.NotEqual(t, a, c)
+}
+
+func BenchmarkKT128(b *testing.B) {
+	data := make([]byte, 1<<20)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h := NewKT128(nil)
+		_, _ = h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+func BenchmarkTurboShake128(b *testing.B) {
+	data := make([]byte, 1<<20)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h := NewTurboShake128(0x1f)
+		_, _ = h.Write(data)
+		h.Sum(nil)
+	}
+}
@@ -30,14 +30,20 @@ var rc = [24]uint64{
 	0x8000000080008008,
 }
 
-// keccakF1600 applies the Keccak permutation to a 1600b-wide
+// keccakF1600Generic applies the Keccak permutation to a 1600b-wide
 // state represented as a slice of 25 uint64s.
-func keccakF1600(a *[25]uint64) {
+func keccakF1600Generic(a *[25]uint64) {
+	keccakP1600Generic(a, 24)
+}
+
+// keccakP1600Generic applies the last rounds rounds of the Keccak permutation,
+// Keccak-p[1600, rounds] of FIPS 202 section 3.3. rounds must be a multiple of 4.
+func keccakP1600Generic(a *[25]uint64, rounds int) {
 	// Implementation translated from Keccak-inplace.c
 	// in the keccak reference code.
 	var t, bc0, bc1, bc2, bc3, bc4, d0, d1, d2, d3, d4 uint64
 
-	for i := 0; i < 24; i += 4 {
+	for i := 24 - rounds; i < 24; i += 4 {
 		// Combines the 5 steps in each round into 2 steps.
 		// Unrolls 4 rounds per loop and spreads some steps across rounds.
 
@@ -0,0 +1,52 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+package sha3
+
+import "golang.org/x/sys/cpu"
+
+//go:generate go run ./_asm -out keccakf_amd64.s
+
+var (
+	useBMI2 = cpu.X86.HasBMI1 && cpu.X86.HasBMI2
+	useAVX2 = cpu.X86.HasAVX2
+)
+
+// keccakP1600BMI2 applies the last rounds rounds of the permutation, rounds must be even.
+//go:noescape
+func keccakP1600BMI2(a *[25]uint64, rounds int)
+
+//go:noescape
+func keccakF1600x4AVX2(a *[lanes][25]uint64)
+
+// keccakF1600 applies the Keccak permutation with RORX and ANDN if the CPU
+// supports BMI1 and BMI2.
+func keccakF1600(a *[25]uint64) {
+	if useBMI2 {
+		keccakP1600BMI2(a, 24)
+		return
+	}
+	keccakF1600Generic(a)
+}
+
+// keccakP12 applies the 12 rounds Keccak-p[1600, 12] of TurboSHAKE.
+func keccakP12(a *[25]uint64) {
+	if useBMI2 {
+		keccakP1600BMI2(a, 12)
+		return
+	}
+	keccakP1600Generic(a, 12)
+}
+
+// keccakF1600x4 permutes the 4 states in the lanes of YMM registers if the
+// CPU supports AVX2.
+func keccakF1600x4(a *[lanes][25]uint64) {
+	if useAVX2 {
+		keccakF1600x4AVX2(a)
+		return
+	}
+	keccakF1600x4Generic(a)
+}
@@ -0,0 +1,44 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+
+//go:build arm64 && !purego
+// +build arm64,!purego
+
+package sha3
+
+import "golang.org/x/sys/cpu"
+
+// useSHA3 enables the Armv8.2 SHA3 instructions (EOR3, RAX1, XAR and BCAX).
+// On some cores they are slower than the generic code, build with the
+// purego tag to opt out.
+var useSHA3 = cpu.ARM64.HasSHA3
+
+// keccakP1600SHA3 applies the last rounds rounds of the permutation.
+//go:noescape
+func keccakP1600SHA3(a *[25]uint64, rounds int)
+
+func keccakF1600(a *[25]uint64) {
+	if useSHA3 {
+		keccakP1600SHA3(a, 24)
+		return
+	}
+	keccakF1600Generic(a)
+}
+
+func keccakP12(a *[25]uint64) {
+	if useSHA3 {
+		keccakP1600SHA3(a, 12)
+		return
+	}
+	keccakP1600Generic(a, 12)
+}
+
+func keccakF1600x4(a *[lanes][25]uint64) {
+	if useSHA3 {
+		for l := range a {
+			keccakP1600SHA3(&a[l], 24)
+		}
+		return
+	}
+	keccakF1600x4Generic(a)
+}
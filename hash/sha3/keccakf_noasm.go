@@ -0,0 +1,19 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+
+//go:build (!amd64 && !arm64) || purego
+// +build !amd64,!arm64 purego
+
+package sha3
+
+func keccakF1600(a *[25]uint64) {
+	keccakF1600Generic(a)
+}
+
+func keccakP12(a *[25]uint64) {
+	keccakP1600Generic(a, 12)
+}
+
+func keccakF1600x4(a *[lanes][25]uint64) {
+	keccakF1600x4Generic(a)
+}
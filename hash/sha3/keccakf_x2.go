@@ -0,0 +1,716 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+package sha3
+
+// keccakF1600x2 applies the Keccak permutation to 2 independent states.
+// It is keccakF1600 with the instructions of the 2 states interleaved, so
+// the CPU can execute the independent dependency chains in parallel.
+func keccakF1600x2(a0, a1 *[25]uint64) {
+	var ta, bc0a, bc1a, bc2a, bc3a, bc4a, d0a, d1a, d2a, d3a, d4a uint64
+	var tb, bc0b, bc1b, bc2b, bc3b, bc4b, d0b, d1b, d2b, d3b, d4b uint64
+
+	for i := 0; i < 24; i += 4 {
+		// Combines the 5 steps in each round into 2 steps.
+		// Unrolls 4 rounds per loop and spreads some steps across rounds.
+
+		// Round 1
+		bc0a = a0[0] ^ a0[5] ^ a0[10] ^ a0[15] ^ a0[20]
+		bc0b = a1[0] ^ a1[5] ^ a1[10] ^ a1[15] ^ a1[20]
+		bc1a = a0[1] ^ a0[6] ^ a0[11] ^ a0[16] ^ a0[21]
+		bc1b = a1[1] ^ a1[6] ^ a1[11] ^ a1[16] ^ a1[21]
+		bc2a = a0[2] ^ a0[7] ^ a0[12] ^ a0[17] ^ a0[22]
+		bc2b = a1[2] ^ a1[7] ^ a1[12] ^ a1[17] ^ a1[22]
+		bc3a = a0[3] ^ a0[8] ^ a0[13] ^ a0[18] ^ a0[23]
+		bc3b = a1[3] ^ a1[8] ^ a1[13] ^ a1[18] ^ a1[23]
+		bc4a = a0[4] ^ a0[9] ^ a0[14] ^ a0[19] ^ a0[24]
+		bc4b = a1[4] ^ a1[9] ^ a1[14] ^ a1[19] ^ a1[24]
+		d0a = bc4a ^ (bc1a<<1 | bc1a>>63)
+		d0b = bc4b ^ (bc1b<<1 | bc1b>>63)
+		d1a = bc0a ^ (bc2a<<1 | bc2a>>63)
+		d1b = bc0b ^ (bc2b<<1 | bc2b>>63)
+		d2a = bc1a ^ (bc3a<<1 | bc3a>>63)
+		d2b = bc1b ^ (bc3b<<1 | bc3b>>63)
+		d3a = bc2a ^ (bc4a<<1 | bc4a>>63)
+		d3b = bc2b ^ (bc4b<<1 | bc4b>>63)
+		d4a = bc3a ^ (bc0a<<1 | bc0a>>63)
+		d4b = bc3b ^ (bc0b<<1 | bc0b>>63)
+
+		bc0a = a0[0] ^ d0a
+		bc0b = a1[0] ^ d0b
+		ta = a0[6] ^ d1a
+		tb = a1[6] ^ d1b
+		bc1a = ta<<44 | ta>>(64-44)
+		bc1b = tb<<44 | tb>>(64-44)
+		ta = a0[12] ^ d2a
+		tb = a1[12] ^ d2b
+		bc2a = ta<<43 | ta>>(64-43)
+		bc2b = tb<<43 | tb>>(64-43)
+		ta = a0[18] ^ d3a
+		tb = a1[18] ^ d3b
+		bc3a = ta<<21 | ta>>(64-21)
+		bc3b = tb<<21 | tb>>(64-21)
+		ta = a0[24] ^ d4a
+		tb = a1[24] ^ d4b
+		bc4a = ta<<14 | ta>>(64-14)
+		bc4b = tb<<14 | tb>>(64-14)
+		a0[0] = bc0a ^ (bc2a &^ bc1a) ^ rc[i]
+		a1[0] = bc0b ^ (bc2b &^ bc1b) ^ rc[i]
+		a0[6] = bc1a ^ (bc3a &^ bc2a)
+		a1[6] = bc1b ^ (bc3b &^ bc2b)
+		a0[12] = bc2a ^ (bc4a &^ bc3a)
+		a1[12] = bc2b ^ (bc4b &^ bc3b)
+		a0[18] = bc3a ^ (bc0a &^ bc4a)
+		a1[18] = bc3b ^ (bc0b &^ bc4b)
+		a0[24] = bc4a ^ (bc1a &^ bc0a)
+		a1[24] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[10] ^ d0a
+		tb = a1[10] ^ d0b
+		bc2a = ta<<3 | ta>>(64-3)
+		bc2b = tb<<3 | tb>>(64-3)
+		ta = a0[16] ^ d1a
+		tb = a1[16] ^ d1b
+		bc3a = ta<<45 | ta>>(64-45)
+		bc3b = tb<<45 | tb>>(64-45)
+		ta = a0[22] ^ d2a
+		tb = a1[22] ^ d2b
+		bc4a = ta<<61 | ta>>(64-61)
+		bc4b = tb<<61 | tb>>(64-61)
+		ta = a0[3] ^ d3a
+		tb = a1[3] ^ d3b
+		bc0a = ta<<28 | ta>>(64-28)
+		bc0b = tb<<28 | tb>>(64-28)
+		ta = a0[9] ^ d4a
+		tb = a1[9] ^ d4b
+		bc1a = ta<<20 | ta>>(64-20)
+		bc1b = tb<<20 | tb>>(64-20)
+		a0[10] = bc0a ^ (bc2a &^ bc1a)
+		a1[10] = bc0b ^ (bc2b &^ bc1b)
+		a0[16] = bc1a ^ (bc3a &^ bc2a)
+		a1[16] = bc1b ^ (bc3b &^ bc2b)
+		a0[22] = bc2a ^ (bc4a &^ bc3a)
+		a1[22] = bc2b ^ (bc4b &^ bc3b)
+		a0[3] = bc3a ^ (bc0a &^ bc4a)
+		a1[3] = bc3b ^ (bc0b &^ bc4b)
+		a0[9] = bc4a ^ (bc1a &^ bc0a)
+		a1[9] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[20] ^ d0a
+		tb = a1[20] ^ d0b
+		bc4a = ta<<18 | ta>>(64-18)
+		bc4b = tb<<18 | tb>>(64-18)
+		ta = a0[1] ^ d1a
+		tb = a1[1] ^ d1b
+		bc0a = ta<<1 | ta>>(64-1)
+		bc0b = tb<<1 | tb>>(64-1)
+		ta = a0[7] ^ d2a
+		tb = a1[7] ^ d2b
+		bc1a = ta<<6 | ta>>(64-6)
+		bc1b = tb<<6 | tb>>(64-6)
+		ta = a0[13] ^ d3a
+		tb = a1[13] ^ d3b
+		bc2a = ta<<25 | ta>>(64-25)
+		bc2b = tb<<25 | tb>>(64-25)
+		ta = a0[19] ^ d4a
+		tb = a1[19] ^ d4b
+		bc3a = ta<<8 | ta>>(64-8)
+		bc3b = tb<<8 | tb>>(64-8)
+		a0[20] = bc0a ^ (bc2a &^ bc1a)
+		a1[20] = bc0b ^ (bc2b &^ bc1b)
+		a0[1] = bc1a ^ (bc3a &^ bc2a)
+		a1[1] = bc1b ^ (bc3b &^ bc2b)
+		a0[7] = bc2a ^ (bc4a &^ bc3a)
+		a1[7] = bc2b ^ (bc4b &^ bc3b)
+		a0[13] = bc3a ^ (bc0a &^ bc4a)
+		a1[13] = bc3b ^ (bc0b &^ bc4b)
+		a0[19] = bc4a ^ (bc1a &^ bc0a)
+		a1[19] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[5] ^ d0a
+		tb = a1[5] ^ d0b
+		bc1a = ta<<36 | ta>>(64-36)
+		bc1b = tb<<36 | tb>>(64-36)
+		ta = a0[11] ^ d1a
+		tb = a1[11] ^ d1b
+		bc2a = ta<<10 | ta>>(64-10)
+		bc2b = tb<<10 | tb>>(64-10)
+		ta = a0[17] ^ d2a
+		tb = a1[17] ^ d2b
+		bc3a = ta<<15 | ta>>(64-15)
+		bc3b = tb<<15 | tb>>(64-15)
+		ta = a0[23] ^ d3a
+		tb = a1[23] ^ d3b
+		bc4a = ta<<56 | ta>>(64-56)
+		bc4b = tb<<56 | tb>>(64-56)
+		ta = a0[4] ^ d4a
+		tb = a1[4] ^ d4b
+		bc0a = ta<<27 | ta>>(64-27)
+		bc0b = tb<<27 | tb>>(64-27)
+		a0[5] = bc0a ^ (bc2a &^ bc1a)
+		a1[5] = bc0b ^ (bc2b &^ bc1b)
+		a0[11] = bc1a ^ (bc3a &^ bc2a)
+		a1[11] = bc1b ^ (bc3b &^ bc2b)
+		a0[17] = bc2a ^ (bc4a &^ bc3a)
+		a1[17] = bc2b ^ (bc4b &^ bc3b)
+		a0[23] = bc3a ^ (bc0a &^ bc4a)
+		a1[23] = bc3b ^ (bc0b &^ bc4b)
+		a0[4] = bc4a ^ (bc1a &^ bc0a)
+		a1[4] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[15] ^ d0a
+		tb = a1[15] ^ d0b
+		bc3a = ta<<41 | ta>>(64-41)
+		bc3b = tb<<41 | tb>>(64-41)
+		ta = a0[21] ^ d1a
+		tb = a1[21] ^ d1b
+		bc4a = ta<<2 | ta>>(64-2)
+		bc4b = tb<<2 | tb>>(64-2)
+		ta = a0[2] ^ d2a
+		tb = a1[2] ^ d2b
+		bc0a = ta<<62 | ta>>(64-62)
+		bc0b = tb<<62 | tb>>(64-62)
+		ta = a0[8] ^ d3a
+		tb = a1[8] ^ d3b
+		bc1a = ta<<55 | ta>>(64-55)
+		bc1b = tb<<55 | tb>>(64-55)
+		ta = a0[14] ^ d4a
+		tb = a1[14] ^ d4b
+		bc2a = ta<<39 | ta>>(64-39)
+		bc2b = tb<<39 | tb>>(64-39)
+		a0[15] = bc0a ^ (bc2a &^ bc1a)
+		a1[15] = bc0b ^ (bc2b &^ bc1b)
+		a0[21] = bc1a ^ (bc3a &^ bc2a)
+		a1[21] = bc1b ^ (bc3b &^ bc2b)
+		a0[2] = bc2a ^ (bc4a &^ bc3a)
+		a1[2] = bc2b ^ (bc4b &^ bc3b)
+		a0[8] = bc3a ^ (bc0a &^ bc4a)
+		a1[8] = bc3b ^ (bc0b &^ bc4b)
+		a0[14] = bc4a ^ (bc1a &^ bc0a)
+		a1[14] = bc4b ^ (bc1b &^ bc0b)
+
+		// Round 2
+		bc0a = a0[0] ^ a0[5] ^ a0[10] ^ a0[15] ^ a0[20]
+		bc0b = a1[0] ^ a1[5] ^ a1[10] ^ a1[15] ^ a1[20]
+		bc1a = a0[1] ^ a0[6] ^ a0[11] ^ a0[16] ^ a0[21]
+		bc1b = a1[1] ^ a1[6] ^ a1[11] ^ a1[16] ^ a1[21]
+		bc2a = a0[2] ^ a0[7] ^ a0[12] ^ a0[17] ^ a0[22]
+		bc2b = a1[2] ^ a1[7] ^ a1[12] ^ a1[17] ^ a1[22]
+		bc3a = a0[3] ^ a0[8] ^ a0[13] ^ a0[18] ^ a0[23]
+		bc3b = a1[3] ^ a1[8] ^ a1[13] ^ a1[18] ^ a1[23]
+		bc4a = a0[4] ^ a0[9] ^ a0[14] ^ a0[19] ^ a0[24]
+		bc4b = a1[4] ^ a1[9] ^ a1[14] ^ a1[19] ^ a1[24]
+		d0a = bc4a ^ (bc1a<<1 | bc1a>>63)
+		d0b = bc4b ^ (bc1b<<1 | bc1b>>63)
+		d1a = bc0a ^ (bc2a<<1 | bc2a>>63)
+		d1b = bc0b ^ (bc2b<<1 | bc2b>>63)
+		d2a = bc1a ^ (bc3a<<1 | bc3a>>63)
+		d2b = bc1b ^ (bc3b<<1 | bc3b>>63)
+		d3a = bc2a ^ (bc4a<<1 | bc4a>>63)
+		d3b = bc2b ^ (bc4b<<1 | bc4b>>63)
+		d4a = bc3a ^ (bc0a<<1 | bc0a>>63)
+		d4b = bc3b ^ (bc0b<<1 | bc0b>>63)
+
+		bc0a = a0[0] ^ d0a
+		bc0b = a1[0] ^ d0b
+		ta = a0[16] ^ d1a
+		tb = a1[16] ^ d1b
+		bc1a = ta<<44 | ta>>(64-44)
+		bc1b = tb<<44 | tb>>(64-44)
+		ta = a0[7] ^ d2a
+		tb = a1[7] ^ d2b
+		bc2a = ta<<43 | ta>>(64-43)
+		bc2b = tb<<43 | tb>>(64-43)
+		ta = a0[23] ^ d3a
+		tb = a1[23] ^ d3b
+		bc3a = ta<<21 | ta>>(64-21)
+		bc3b = tb<<21 | tb>>(64-21)
+		ta = a0[14] ^ d4a
+		tb = a1[14] ^ d4b
+		bc4a = ta<<14 | ta>>(64-14)
+		bc4b = tb<<14 | tb>>(64-14)
+		a0[0] = bc0a ^ (bc2a &^ bc1a) ^ rc[i+1]
+		a1[0] = bc0b ^ (bc2b &^ bc1b) ^ rc[i+1]
+		a0[16] = bc1a ^ (bc3a &^ bc2a)
+		a1[16] = bc1b ^ (bc3b &^ bc2b)
+		a0[7] = bc2a ^ (bc4a &^ bc3a)
+		a1[7] = bc2b ^ (bc4b &^ bc3b)
+		a0[23] = bc3a ^ (bc0a &^ bc4a)
+		a1[23] = bc3b ^ (bc0b &^ bc4b)
+		a0[14] = bc4a ^ (bc1a &^ bc0a)
+		a1[14] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[20] ^ d0a
+		tb = a1[20] ^ d0b
+		bc2a = ta<<3 | ta>>(64-3)
+		bc2b = tb<<3 | tb>>(64-3)
+		ta = a0[11] ^ d1a
+		tb = a1[11] ^ d1b
+		bc3a = ta<<45 | ta>>(64-45)
+		bc3b = tb<<45 | tb>>(64-45)
+		ta = a0[2] ^ d2a
+		tb = a1[2] ^ d2b
+		bc4a = ta<<61 | ta>>(64-61)
+		bc4b = tb<<61 | tb>>(64-61)
+		ta = a0[18] ^ d3a
+		tb = a1[18] ^ d3b
+		bc0a = ta<<28 | ta>>(64-28)
+		bc0b = tb<<28 | tb>>(64-28)
+		ta = a0[9] ^ d4a
+		tb = a1[9] ^ d4b
+		bc1a = ta<<20 | ta>>(64-20)
+		bc1b = tb<<20 | tb>>(64-20)
+		a0[20] = bc0a ^ (bc2a &^ bc1a)
+		a1[20] = bc0b ^ (bc2b &^ bc1b)
+		a0[11] = bc1a ^ (bc3a &^ bc2a)
+		a1[11] = bc1b ^ (bc3b &^ bc2b)
+		a0[2] = bc2a ^ (bc4a &^ bc3a)
+		a1[2] = bc2b ^ (bc4b &^ bc3b)
+		a0[18] = bc3a ^ (bc0a &^ bc4a)
+		a1[18] = bc3b ^ (bc0b &^ bc4b)
+		a0[9] = bc4a ^ (bc1a &^ bc0a)
+		a1[9] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[15] ^ d0a
+		tb = a1[15] ^ d0b
+		bc4a = ta<<18 | ta>>(64-18)
+		bc4b = tb<<18 | tb>>(64-18)
+		ta = a0[6] ^ d1a
+		tb = a1[6] ^ d1b
+		bc0a = ta<<1 | ta>>(64-1)
+		bc0b = tb<<1 | tb>>(64-1)
+		ta = a0[22] ^ d2a
+		tb = a1[22] ^ d2b
+		bc1a = ta<<6 | ta>>(64-6)
+		bc1b = tb<<6 | tb>>(64-6)
+		ta = a0[13] ^ d3a
+		tb = a1[13] ^ d3b
+		bc2a = ta<<25 | ta>>(64-25)
+		bc2b = tb<<25 | tb>>(64-25)
+		ta = a0[4] ^ d4a
+		tb = a1[4] ^ d4b
+		bc3a = ta<<8 | ta>>(64-8)
+		bc3b = tb<<8 | tb>>(64-8)
+		a0[15] = bc0a ^ (bc2a &^ bc1a)
+		a1[15] = bc0b ^ (bc2b &^ bc1b)
+		a0[6] = bc1a ^ (bc3a &^ bc2a)
+		a1[6] = bc1b ^ (bc3b &^ bc2b)
+		a0[22] = bc2a ^ (bc4a &^ bc3a)
+		a1[22] = bc2b ^ (bc4b &^ bc3b)
+		a0[13] = bc3a ^ (bc0a &^ bc4a)
+		a1[13] = bc3b ^ (bc0b &^ bc4b)
+		a0[4] = bc4a ^ (bc1a &^ bc0a)
+		a1[4] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[10] ^ d0a
+		tb = a1[10] ^ d0b
+		bc1a = ta<<36 | ta>>(64-36)
+		bc1b = tb<<36 | tb>>(64-36)
+		ta = a0[1] ^ d1a
+		tb = a1[1] ^ d1b
+		bc2a = ta<<10 | ta>>(64-10)
+		bc2b = tb<<10 | tb>>(64-10)
+		ta = a0[17] ^ d2a
+		tb = a1[17] ^ d2b
+		bc3a = ta<<15 | ta>>(64-15)
+		bc3b = tb<<15 | tb>>(64-15)
+		ta = a0[8] ^ d3a
+		tb = a1[8] ^ d3b
+		bc4a = ta<<56 | ta>>(64-56)
+		bc4b = tb<<56 | tb>>(64-56)
+		ta = a0[24] ^ d4a
+		tb = a1[24] ^ d4b
+		bc0a = ta<<27 | ta>>(64-27)
+		bc0b = tb<<27 | tb>>(64-27)
+		a0[10] = bc0a ^ (bc2a &^ bc1a)
+		a1[10] = bc0b ^ (bc2b &^ bc1b)
+		a0[1] = bc1a ^ (bc3a &^ bc2a)
+		a1[1] = bc1b ^ (bc3b &^ bc2b)
+		a0[17] = bc2a ^ (bc4a &^ bc3a)
+		a1[17] = bc2b ^ (bc4b &^ bc3b)
+		a0[8] = bc3a ^ (bc0a &^ bc4a)
+		a1[8] = bc3b ^ (bc0b &^ bc4b)
+		a0[24] = bc4a ^ (bc1a &^ bc0a)
+		a1[24] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[5] ^ d0a
+		tb = a1[5] ^ d0b
+		bc3a = ta<<41 | ta>>(64-41)
+		bc3b = tb<<41 | tb>>(64-41)
+		ta = a0[21] ^ d1a
+		tb = a1[21] ^ d1b
+		bc4a = ta<<2 | ta>>(64-2)
+		bc4b = tb<<2 | tb>>(64-2)
+		ta = a0[12] ^ d2a
+		tb = a1[12] ^ d2b
+		bc0a = ta<<62 | ta>>(64-62)
+		bc0b = tb<<62 | tb>>(64-62)
+		ta = a0[3] ^ d3a
+		tb = a1[3] ^ d3b
+		bc1a = ta<<55 | ta>>(64-55)
+		bc1b = tb<<55 | tb>>(64-55)
+		ta = a0[19] ^ d4a
+		tb = a1[19] ^ d4b
+		bc2a = ta<<39 | ta>>(64-39)
+		bc2b = tb<<39 | tb>>(64-39)
+		a0[5] = bc0a ^ (bc2a &^ bc1a)
+		a1[5] = bc0b ^ (bc2b &^ bc1b)
+		a0[21] = bc1a ^ (bc3a &^ bc2a)
+		a1[21] = bc1b ^ (bc3b &^ bc2b)
+		a0[12] = bc2a ^ (bc4a &^ bc3a)
+		a1[12] = bc2b ^ (bc4b &^ bc3b)
+		a0[3] = bc3a ^ (bc0a &^ bc4a)
+		a1[3] = bc3b ^ (bc0b &^ bc4b)
+		a0[19] = bc4a ^ (bc1a &^ bc0a)
+		a1[19] = bc4b ^ (bc1b &^ bc0b)
+
+		// Round 3
+		bc0a = a0[0] ^ a0[5] ^ a0[10] ^ a0[15] ^ a0[20]
+		bc0b = a1[0] ^ a1[5] ^ a1[10] ^ a1[15] ^ a1[20]
+		bc1a = a0[1] ^ a0[6] ^ a0[11] ^ a0[16] ^ a0[21]
+		bc1b = a1[1] ^ a1[6] ^ a1[11] ^ a1[16] ^ a1[21]
+		bc2a = a0[2] ^ a0[7] ^ a0[12] ^ a0[17] ^ a0[22]
+		bc2b = a1[2] ^ a1[7] ^ a1[12] ^ a1[17] ^ a1[22]
+		bc3a = a0[3] ^ a0[8] ^ a0[13] ^ a0[18] ^ a0[23]
+		bc3b = a1[3] ^ a1[8] ^ a1[13] ^ a1[18] ^ a1[23]
+		bc4a = a0[4] ^ a0[9] ^ a0[14] ^ a0[19] ^ a0[24]
+		bc4b = a1[4] ^ a1[9] ^ a1[14] ^ a1[19] ^ a1[24]
+		d0a = bc4a ^ (bc1a<<1 | bc1a>>63)
+		d0b = bc4b ^ (bc1b<<1 | bc1b>>63)
+		d1a = bc0a ^ (bc2a<<1 | bc2a>>63)
+		d1b = bc0b ^ (bc2b<<1 | bc2b>>63)
+		d2a = bc1a ^ (bc3a<<1 | bc3a>>63)
+		d2b = bc1b ^ (bc3b<<1 | bc3b>>63)
+		d3a = bc2a ^ (bc4a<<1 | bc4a>>63)
+		d3b = bc2b ^ (bc4b<<1 | bc4b>>63)
+		d4a = bc3a ^ (bc0a<<1 | bc0a>>63)
+		d4b = bc3b ^ (bc0b<<1 | bc0b>>63)
+
+		bc0a = a0[0] ^ d0a
+		bc0b = a1[0] ^ d0b
+		ta = a0[11] ^ d1a
+		tb = a1[11] ^ d1b
+		bc1a = ta<<44 | ta>>(64-44)
+		bc1b = tb<<44 | tb>>(64-44)
+		ta = a0[22] ^ d2a
+		tb = a1[22] ^ d2b
+		bc2a = ta<<43 | ta>>(64-43)
+		bc2b = tb<<43 | tb>>(64-43)
+		ta = a0[8] ^ d3a
+		tb = a1[8] ^ d3b
+		bc3a = ta<<21 | ta>>(64-21)
+		bc3b = tb<<21 | tb>>(64-21)
+		ta = a0[19] ^ d4a
+		tb = a1[19] ^ d4b
+		bc4a = ta<<14 | ta>>(64-14)
+		bc4b = tb<<14 | tb>>(64-14)
+		a0[0] = bc0a ^ (bc2a &^ bc1a) ^ rc[i+2]
+		a1[0] = bc0b ^ (bc2b &^ bc1b) ^ rc[i+2]
+		a0[11] = bc1a ^ (bc3a &^ bc2a)
+		a1[11] = bc1b ^ (bc3b &^ bc2b)
+		a0[22] = bc2a ^ (bc4a &^ bc3a)
+		a1[22] = bc2b ^ (bc4b &^ bc3b)
+		a0[8] = bc3a ^ (bc0a &^ bc4a)
+		a1[8] = bc3b ^ (bc0b &^ bc4b)
+		a0[19] = bc4a ^ (bc1a &^ bc0a)
+		a1[19] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[15] ^ d0a
+		tb = a1[15] ^ d0b
+		bc2a = ta<<3 | ta>>(64-3)
+		bc2b = tb<<3 | tb>>(64-3)
+		ta = a0[1] ^ d1a
+		tb = a1[1] ^ d1b
+		bc3a = ta<<45 | ta>>(64-45)
+		bc3b = tb<<45 | tb>>(64-45)
+		ta = a0[12] ^ d2a
+		tb = a1[12] ^ d2b
+		bc4a = ta<<61 | ta>>(64-61)
+		bc4b = tb<<61 | tb>>(64-61)
+		ta = a0[23] ^ d3a
+		tb = a1[23] ^ d3b
+		bc0a = ta<<28 | ta>>(64-28)
+		bc0b = tb<<28 | tb>>(64-28)
+		ta = a0[9] ^ d4a
+		tb = a1[9] ^ d4b
+		bc1a = ta<<20 | ta>>(64-20)
+		bc1b = tb<<20 | tb>>(64-20)
+		a0[15] = bc0a ^ (bc2a &^ bc1a)
+		a1[15] = bc0b ^ (bc2b &^ bc1b)
+		a0[1] = bc1a ^ (bc3a &^ bc2a)
+		a1[1] = bc1b ^ (bc3b &^ bc2b)
+		a0[12] = bc2a ^ (bc4a &^ bc3a)
+		a1[12] = bc2b ^ (bc4b &^ bc3b)
+		a0[23] = bc3a ^ (bc0a &^ bc4a)
+		a1[23] = bc3b ^ (bc0b &^ bc4b)
+		a0[9] = bc4a ^ (bc1a &^ bc0a)
+		a1[9] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[5] ^ d0a
+		tb = a1[5] ^ d0b
+		bc4a = ta<<18 | ta>>(64-18)
+		bc4b = tb<<18 | tb>>(64-18)
+		ta = a0[16] ^ d1a
+		tb = a1[16] ^ d1b
+		bc0a = ta<<1 | ta>>(64-1)
+		bc0b = tb<<1 | tb>>(64-1)
+		ta = a0[2] ^ d2a
+		tb = a1[2] ^ d2b
+		bc1a = ta<<6 | ta>>(64-6)
+		bc1b = tb<<6 | tb>>(64-6)
+		ta = a0[13] ^ d3a
+		tb = a1[13] ^ d3b
+		bc2a = ta<<25 | ta>>(64-25)
+		bc2b = tb<<25 | tb>>(64-25)
+		ta = a0[24] ^ d4a
+		tb = a1[24] ^ d4b
+		bc3a = ta<<8 | ta>>(64-8)
+		bc3b = tb<<8 | tb>>(64-8)
+		a0[5] = bc0a ^ (bc2a &^ bc1a)
+		a1[5] = bc0b ^ (bc2b &^ bc1b)
+		a0[16] = bc1a ^ (bc3a &^ bc2a)
+		a1[16] = bc1b ^ (bc3b &^ bc2b)
+		a0[2] = bc2a ^ (bc4a &^ bc3a)
+		a1[2] = bc2b ^ (bc4b &^ bc3b)
+		a0[13] = bc3a ^ (bc0a &^ bc4a)
+		a1[13] = bc3b ^ (bc0b &^ bc4b)
+		a0[24] = bc4a ^ (bc1a &^ bc0a)
+		a1[24] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[20] ^ d0a
+		tb = a1[20] ^ d0b
+		bc1a = ta<<36 | ta>>(64-36)
+		bc1b = tb<<36 | tb>>(64-36)
+		ta = a0[6] ^ d1a
+		tb = a1[6] ^ d1b
+		bc2a = ta<<10 | ta>>(64-10)
+		bc2b = tb<<10 | tb>>(64-10)
+		ta = a0[17] ^ d2a
+		tb = a1[17] ^ d2b
+		bc3a = ta<<15 | ta>>(64-15)
+		bc3b = tb<<15 | tb>>(64-15)
+		ta = a0[3] ^ d3a
+		tb = a1[3] ^ d3b
+		bc4a = ta<<56 | ta>>(64-56)
+		bc4b = tb<<56 | tb>>(64-56)
+		ta = a0[14] ^ d4a
+		tb = a1[14] ^ d4b
+		bc0a = ta<<27 | ta>>(64-27)
+		bc0b = tb<<27 | tb>>(64-27)
+		a0[20] = bc0a ^ (bc2a &^ bc1a)
+		a1[20] = bc0b ^ (bc2b &^ bc1b)
+		a0[6] = bc1a ^ (bc3a &^ bc2a)
+		a1[6] = bc1b ^ (bc3b &^ bc2b)
+		a0[17] = bc2a ^ (bc4a &^ bc3a)
+		a1[17] = bc2b ^ (bc4b &^ bc3b)
+		a0[3] = bc3a ^ (bc0a &^ bc4a)
+		a1[3] = bc3b ^ (bc0b &^ bc4b)
+		a0[14] = bc4a ^ (bc1a &^ bc0a)
+		a1[14] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[10] ^ d0a
+		tb = a1[10] ^ d0b
+		bc3a = ta<<41 | ta>>(64-41)
+		bc3b = tb<<41 | tb>>(64-41)
+		ta = a0[21] ^ d1a
+		tb = a1[21] ^ d1b
+		bc4a = ta<<2 | ta>>(64-2)
+		bc4b = tb<<2 | tb>>(64-2)
+		ta = a0[7] ^ d2a
+		tb = a1[7] ^ d2b
+		bc0a = ta<<62 | ta>>(64-62)
+		bc0b = tb<<62 | tb>>(64-62)
+		ta = a0[18] ^ d3a
+		tb = a1[18] ^ d3b
+		bc1a = ta<<55 | ta>>(64-55)
+		bc1b = tb<<55 | tb>>(64-55)
+		ta = a0[4] ^ d4a
+		tb = a1[4] ^ d4b
+		bc2a = ta<<39 | ta>>(64-39)
+		bc2b = tb<<39 | tb>>(64-39)
+		a0[10] = bc0a ^ (bc2a &^ bc1a)
+		a1[10] = bc0b ^ (bc2b &^ bc1b)
+		a0[21] = bc1a ^ (bc3a &^ bc2a)
+		a1[21] = bc1b ^ (bc3b &^ bc2b)
+		a0[7] = bc2a ^ (bc4a &^ bc3a)
+		a1[7] = bc2b ^ (bc4b &^ bc3b)
+		a0[18] = bc3a ^ (bc0a &^ bc4a)
+		a1[18] = bc3b ^ (bc0b &^ bc4b)
+		a0[4] = bc4a ^ (bc1a &^ bc0a)
+		a1[4] = bc4b ^ (bc1b &^ bc0b)
+
+		// Round 4
+		bc0a = a0[0] ^ a0[5] ^ a0[10] ^ a0[15] ^ a0[20]
+		bc0b = a1[0] ^ a1[5] ^ a1[10] ^ a1[15] ^ a1[20]
+		bc1a = a0[1] ^ a0[6] ^ a0[11] ^ a0[16] ^ a0[21]
+		bc1b = a1[1] ^ a1[6] ^ a1[11] ^ a1[16] ^ a1[21]
+		bc2a = a0[2] ^ a0[7] ^ a0[12] ^ a0[17] ^ a0[22]
+		bc2b = a1[2] ^ a1[7] ^ a1[12] ^ a1[17] ^ a1[22]
+		bc3a = a0[3] ^ a0[8] ^ a0[13] ^ a0[18] ^ a0[23]
+		bc3b = a1[3] ^ a1[8] ^ a1[13] ^ a1[18] ^ a1[23]
+		bc4a = a0[4] ^ a0[9] ^ a0[14] ^ a0[19] ^ a0[24]
+		bc4b = a1[4] ^ a1[9] ^ a1[14] ^ a1[19] ^ a1[24]
+		d0a = bc4a ^ (bc1a<<1 | bc1a>>63)
+		d0b = bc4b ^ (bc1b<<1 | bc1b>>63)
+		d1a = bc0a ^ (bc2a<<1 | bc2a>>63)
+		d1b = bc0b ^ (bc2b<<1 | bc2b>>63)
+		d2a = bc1a ^ (bc3a<<1 | bc3a>>63)
+		d2b = bc1b ^ (bc3b<<1 | bc3b>>63)
+		d3a = bc2a ^ (bc4a<<1 | bc4a>>63)
+		d3b = bc2b ^ (bc4b<<1 | bc4b>>63)
+		d4a = bc3a ^ (bc0a<<1 | bc0a>>63)
+		d4b = bc3b ^ (bc0b<<1 | bc0b>>63)
+
+		bc0a = a0[0] ^ d0a
+		bc0b = a1[0] ^ d0b
+		ta = a0[1] ^ d1a
+		tb = a1[1] ^ d1b
+		bc1a = ta<<44 | ta>>(64-44)
+		bc1b = tb<<44 | tb>>(64-44)
+		ta = a0[2] ^ d2a
+		tb = a1[2] ^ d2b
+		bc2a = ta<<43 | ta>>(64-43)
+		bc2b = tb<<43 | tb>>(64-43)
+		ta = a0[3] ^ d3a
+		tb = a1[3] ^ d3b
+		bc3a = ta<<21 | ta>>(64-21)
+		bc3b = tb<<21 | tb>>(64-21)
+		ta = a0[4] ^ d4a
+		tb = a1[4] ^ d4b
+		bc4a = ta<<14 | ta>>(64-14)
+		bc4b = tb<<14 | tb>>(64-14)
+		a0[0] = bc0a ^ (bc2a &^ bc1a) ^ rc[i+3]
+		a1[0] = bc0b ^ (bc2b &^ bc1b) ^ rc[i+3]
+		a0[1] = bc1a ^ (bc3a &^ bc2a)
+		a1[1] = bc1b ^ (bc3b &^ bc2b)
+		a0[2] = bc2a ^ (bc4a &^ bc3a)
+		a1[2] = bc2b ^ (bc4b &^ bc3b)
+		a0[3] = bc3a ^ (bc0a &^ bc4a)
+		a1[3] = bc3b ^ (bc0b &^ bc4b)
+		a0[4] = bc4a ^ (bc1a &^ bc0a)
+		a1[4] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[5] ^ d0a
+		tb = a1[5] ^ d0b
+		bc2a = ta<<3 | ta>>(64-3)
+		bc2b = tb<<3 | tb>>(64-3)
+		ta = a0[6] ^ d1a
+		tb = a1[6] ^ d1b
+		bc3a = ta<<45 | ta>>(64-45)
+		bc3b = tb<<45 | tb>>(64-45)
+		ta = a0[7] ^ d2a
+		tb = a1[7] ^ d2b
+		bc4a = ta<<61 | ta>>(64-61)
+		bc4b = tb<<61 | tb>>(64-61)
+		ta = a0[8] ^ d3a
+		tb = a1[8] ^ d3b
+		bc0a = ta<<28 | ta>>(64-28)
+		bc0b = tb<<28 | tb>>(64-28)
+		ta = a0[9] ^ d4a
+		tb = a1[9] ^ d4b
+		bc1a = ta<<20 | ta>>(64-20)
+		bc1b = tb<<20 | tb>>(64-20)
+		a0[5] = bc0a ^ (bc2a &^ bc1a)
+		a1[5] = bc0b ^ (bc2b &^ bc1b)
+		a0[6] = bc1a ^ (bc3a &^ bc2a)
+		a1[6] = bc1b ^ (bc3b &^ bc2b)
+		a0[7] = bc2a ^ (bc4a &^ bc3a)
+		a1[7] = bc2b ^ (bc4b &^ bc3b)
+		a0[8] = bc3a ^ (bc0a &^ bc4a)
+		a1[8] = bc3b ^ (bc0b &^ bc4b)
+		a0[9] = bc4a ^ (bc1a &^ bc0a)
+		a1[9] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[10] ^ d0a
+		tb = a1[10] ^ d0b
+		bc4a = ta<<18 | ta>>(64-18)
+		bc4b = tb<<18 | tb>>(64-18)
+		ta = a0[11] ^ d1a
+		tb = a1[11] ^ d1b
+		bc0a = ta<<1 | ta>>(64-1)
+		bc0b = tb<<1 | tb>>(64-1)
+		ta = a0[12] ^ d2a
+		tb = a1[12] ^ d2b
+		bc1a = ta<<6 | ta>>(64-6)
+		bc1b = tb<<6 | tb>>(64-6)
+		ta = a0[13] ^ d3a
+		tb = a1[13] ^ d3b
+		bc2a = ta<<25 | ta>>(64-25)
+		bc2b = tb<<25 | tb>>(64-25)
+		ta = a0[14] ^ d4a
+		tb = a1[14] ^ d4b
+		bc3a = ta<<8 | ta>>(64-8)
+		bc3b = tb<<8 | tb>>(64-8)
+		a0[10] = bc0a ^ (bc2a &^ bc1a)
+		a1[10] = bc0b ^ (bc2b &^ bc1b)
+		a0[11] = bc1a ^ (bc3a &^ bc2a)
+		a1[11] = bc1b ^ (bc3b &^ bc2b)
+		a0[12] = bc2a ^ (bc4a &^ bc3a)
+		a1[12] = bc2b ^ (bc4b &^ bc3b)
+		a0[13] = bc3a ^ (bc0a &^ bc4a)
+		a1[13] = bc3b ^ (bc0b &^ bc4b)
+		a0[14] = bc4a ^ (bc1a &^ bc0a)
+		a1[14] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[15] ^ d0a
+		tb = a1[15] ^ d0b
+		bc1a = ta<<36 | ta>>(64-36)
+		bc1b = tb<<36 | tb>>(64-36)
+		ta = a0[16] ^ d1a
+		tb = a1[16] ^ d1b
+		bc2a = ta<<10 | ta>>(64-10)
+		bc2b = tb<<10 | tb>>(64-10)
+		ta = a0[17] ^ d2a
+		tb = a1[17] ^ d2b
+		bc3a = ta<<15 | ta>>(64-15)
+		bc3b = tb<<15 | tb>>(64-15)
+		ta = a0[18] ^ d3a
+		tb = a1[18] ^ d3b
+		bc4a = ta<<56 | ta>>(64-56)
+		bc4b = tb<<56 | tb>>(64-56)
+		ta = a0[19] ^ d4a
+		tb = a1[19] ^ d4b
+		bc0a = ta<<27 | ta>>(64-27)
+		bc0b = tb<<27 | tb>>(64-27)
+		a0[15] = bc0a ^ (bc2a &^ bc1a)
+		a1[15] = bc0b ^ (bc2b &^ bc1b)
+		a0[16] = bc1a ^ (bc3a &^ bc2a)
+		a1[16] = bc1b ^ (bc3b &^ bc2b)
+		a0[17] = bc2a ^ (bc4a &^ bc3a)
+		a1[17] = bc2b ^ (bc4b &^ bc3b)
+		a0[18] = bc3a ^ (bc0a &^ bc4a)
+		a1[18] = bc3b ^ (bc0b &^ bc4b)
+		a0[19] = bc4a ^ (bc1a &^ bc0a)
+		a1[19] = bc4b ^ (bc1b &^ bc0b)
+
+		ta = a0[20] ^ d0a
+		tb = a1[20] ^ d0b
+		bc3a = ta<<41 | ta>>(64-41)
+		bc3b = tb<<41 | tb>>(64-41)
+		ta = a0[21] ^ d1a
+		tb = a1[21] ^ d1b
+		bc4a = ta<<2 | ta>>(64-2)
+		bc4b = tb<<2 | tb>>(64-2)
+		ta = a0[22] ^ d2a
+		tb = a1[22] ^ d2b
+		bc0a = ta<<62 | ta>>(64-62)
+		bc0b = tb<<62 | tb>>(64-62)
+		ta = a0[23] ^ d3a
+		tb = a1[23] ^ d3b
+		bc1a = ta<<55 | ta>>(64-55)
+		bc1b = tb<<55 | tb>>(64-55)
+		ta = a0[24] ^ d4a
+		tb = a1[24] ^ d4b
+		bc2a = ta<<39 | ta>>(64-39)
+		bc2b = tb<<39 | tb>>(64-39)
+		a0[20] = bc0a ^ (bc2a &^ bc1a)
+		a1[20] = bc0b ^ (bc2b &^ bc1b)
+		a0[21] = bc1a ^ (bc3a &^ bc2a)
+		a1[21] = bc1b ^ (bc3b &^ bc2b)
+		a0[22] = bc2a ^ (bc4a &^ bc3a)
+		a1[22] = bc2b ^ (bc4b &^ bc3b)
+		a0[23] = bc3a ^ (bc0a &^ bc4a)
+		a1[23] = bc3b ^ (bc0b &^ bc4b)
+		a0[24] = bc4a ^ (bc1a &^ bc0a)
+		a1[24] = bc4b ^ (bc1b &^ bc0b)
+	}
+}
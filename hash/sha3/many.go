@@ -0,0 +1,116 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+package sha3
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// lanes is the number of states SumMany permutes in one pass.
+const lanes = 4
+
+// keccakF1600x4Generic applies the Keccak permutation to 4 independent states.
+func keccakF1600x4Generic(a *[lanes][25]uint64) {
+	keccakF1600x2(&a[0], &a[1])
+	keccakF1600x2(&a[2], &a[3])
+}
+
+// keccakP12x4 applies the TurboSHAKE permutation to 4 independent states.
+func keccakP12x4(a *[lanes][25]uint64) {
+	for l := range a {
+		keccakP12(&a[l])
+	}
+}
+
+// lane is a message being absorbed in one of the interleaved states.
+type lane struct {
+	msg    []byte
+	index  int
+	active bool
+	final  bool
+}
+
+// SumMany computes the digest of every message with the algorithm of h,
+// the i-th digest is of msgs[i]. Up to 4 messages are absorbed side by side
+// and their states are permuted in one pass. h is only used to choose the
+// algorithm and is not modified; SumMany returns nil if h was not created by
+// this package.
+func SumMany(h hash.Hash, msgs [][]byte) [][]byte {
+	proto, ok := h.(*state)
+	if !ok {
+		return nil
+	}
+	rate, outputLen, dsbyte := proto.rate, proto.outputLen, proto.dsbyte
+	permute := keccakF1600x4
+	if proto.turbo {
+		permute = keccakP12x4
+	}
+
+	out := make([][]byte, len(msgs))
+	digests := make([]byte, outputLen*len(msgs))
+	var (
+		a     [lanes][25]uint64
+		ls    [lanes]lane
+		block [maxRate]byte
+		next  int
+	)
+	for {
+		busy := false
+		for l := range ls {
+			ln := &ls[l]
+			if !ln.active {
+				if next == len(msgs) {
+					continue
+				}
+				a[l] = [25]uint64{}
+				*ln = lane{msg: msgs[next], index: next, active: true}
+				next++
+			}
+			busy = true
+			if len(ln.msg) >= rate {
+				xorInLane(&a[l], ln.msg[:rate])
+				ln.msg = ln.msg[rate:]
+				continue
+			}
+			// the last block, apply the same padding as padAndPermute
+			n := copy(block[:], ln.msg)
+			block[n] = dsbyte
+			for i := n + 1; i < rate; i++ {
+				block[i] = 0
+			}
+			block[rate-1] ^= 0x80
+			xorInLane(&a[l], block[:rate])
+			ln.final = true
+		}
+		if !busy {
+			return out
+		}
+		permute(&a)
+		for l := range ls {
+			ln := &ls[l]
+			if ln.active && ln.final {
+				d := digests[ln.index*outputLen : (ln.index+1)*outputLen : (ln.index+1)*outputLen]
+				copyOutLane(&a[l], d)
+				out[ln.index] = d
+				ln.active = false
+			}
+		}
+	}
+}
+
+// xorInLane xors a block of len(buf) bytes into the state, len(buf) must be a multiple of 8.
+func xorInLane(a *[25]uint64, buf []byte) {
+	for i := 0; i < len(buf)/8; i++ {
+		a[i] ^= binary.LittleEndian.Uint64(buf[i*8:])
+	}
+}
+
+// copyOutLane copies the first len(out) bytes of the state.
+func copyOutLane(a *[25]uint64, out []byte) {
+	var tmp [8]byte
+	for i := 0; len(out) > 0; i++ {
+		binary.LittleEndian.PutUint64(tmp[:], a[i])
+		out = out[copy(out, tmp[:]):]
+	}
+}
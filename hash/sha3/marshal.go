@@ -0,0 +1,190 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+package sha3
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// The marshaled state is laid out as magic || rate || dsbyte || outputLen ||
+// direction || a || position || storage, where position is the number of
+// buffered bytes while absorbing and the number of already squeezed bytes
+// of the current block while squeezing. TurboSHAKE states use turboMagic.
+const (
+	magic         = "sha3\x01"
+	turboMagic    = "tshk\x01"
+	marshaledSize = len(magic) + 4 + 25*8 + 1 + maxRate
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler. The result can be
+// restored by UnmarshalBinary on a hash of the same algorithm.
+func (d *state) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize)
+	if d.turbo {
+		b = append(b, turboMagic...)
+	} else {
+		b = append(b, magic...)
+	}
+	b = append(b, byte(d.rate), d.dsbyte, byte(d.outputLen), byte(d.state))
+	var lane [8]byte
+	for i := range d.a {
+		binary.LittleEndian.PutUint64(lane[:], d.a[i])
+		b = append(b, lane[:]...)
+	}
+	if d.state == spongeAbsorbing {
+		b = append(b, byte(len(d.buf)))
+	} else {
+		b = append(b, byte(d.rate-len(d.buf)))
+	}
+	b = append(b, d.storage[:]...)
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The state must
+// have been produced by MarshalBinary of the same algorithm.
+func (d *state) UnmarshalBinary(b []byte) error {
+	want := magic
+	if d.turbo {
+		want = turboMagic
+	}
+	if len(b) != marshaledSize || string(b[:len(magic)]) != want {
+		return errors.New("sha3: invalid hash state")
+	}
+	b = b[len(magic):]
+	if int(b[0]) != d.rate || b[1] != d.dsbyte || int(b[2]) != d.outputLen {
+		return errors.New("sha3: hash state belongs to another algorithm")
+	}
+	direction := spongeDirection(b[3])
+	if direction != spongeAbsorbing && direction != spongeSqueezing {
+		return errors.New("sha3: invalid sponge direction")
+	}
+	b = b[4:]
+	for i := range d.a {
+		d.a[i] = binary.LittleEndian.Uint64(b[i*8:])
+	}
+	b = b[25*8:]
+	pos := int(b[0])
+	if pos > d.rate || direction == spongeAbsorbing && pos == d.rate {
+		return errors.New("sha3: invalid buffer position")
+	}
+	copy(d.storage[:], b[1:])
+	d.state = direction
+	if direction == spongeAbsorbing {
+		d.buf = d.storage[:pos]
+	} else {
+		d.buf = d.storage[pos:d.rate]
+	}
+	return nil
+}
+
+// The marshaled state of KangarooTwelve is kangarooMagic || cvLen || phase ||
+// leaves || len(custom) || custom || len(buf) || buf || sponge, where the
+// integers are big-endian, buf is the partial leaf and sponge the marshaled
+// final node of the tree, or the output after the first Read.
+const kangarooMagic = "kt12\x01"
+
+// the phases of a marshaled KangarooTwelve
+const (
+	kangarooBuffering byte = iota
+	kangarooTree
+	kangarooSqueezing
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler. The full leaves in the
+// buffer are hashed first, so the result holds less than one leaf of input.
+func (k *kangaroo) MarshalBinary() ([]byte, error) {
+	dup := k
+	if k.out == nil {
+		dup = k.clone()
+		dup.flush(false)
+	}
+	b := make([]byte, 0, len(kangarooMagic)+14+len(dup.custom)+len(dup.buf)+marshaledSize)
+	b = append(b, kangarooMagic...)
+	phase, sponge := kangarooBuffering, dup.final
+	if dup.out != nil {
+		phase, sponge = kangarooSqueezing, dup.out
+	} else if dup.final != nil {
+		phase = kangarooTree
+	}
+	var n [8]byte
+	binary.BigEndian.PutUint64(n[:], dup.leaves)
+	b = append(b, byte(dup.cvLen), phase)
+	b = append(b, n[:]...)
+	binary.BigEndian.PutUint32(n[:], uint32(len(dup.custom)))
+	b = append(b, n[:4]...)
+	b = append(b, dup.custom...)
+	binary.BigEndian.PutUint32(n[:], uint32(len(dup.buf)))
+	b = append(b, n[:4]...)
+	b = append(b, dup.buf...)
+	if sponge != nil {
+		s, err := sponge.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, s...)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The state must have
+// been produced by MarshalBinary of the same algorithm, it also restores the
+// customization string.
+func (k *kangaroo) UnmarshalBinary(b []byte) error {
+	if len(b) < len(kangarooMagic)+14 || string(b[:len(kangarooMagic)]) != kangarooMagic {
+		return errors.New("sha3: invalid hash state")
+	}
+	b = b[len(kangarooMagic):]
+	if int(b[0]) != k.cvLen {
+		return errors.New("sha3: hash state belongs to another algorithm")
+	}
+	phase, leaves := b[1], binary.BigEndian.Uint64(b[2:])
+	b = b[10:]
+	custom, b, ok := cutLength(b)
+	if !ok {
+		return errors.New("sha3: invalid customization string")
+	}
+	buf, b, ok := cutLength(b)
+	if !ok || len(buf) >= chunkSize {
+		return errors.New("sha3: invalid buffer length")
+	}
+
+	var final, out *state
+	switch phase {
+	case kangarooBuffering:
+		if len(b) != 0 || leaves != 0 {
+			return errors.New("sha3: invalid hash state")
+		}
+	case kangarooTree, kangarooSqueezing:
+		// the output of a message of one chunk is a TurboSHAKE with domain 0x07,
+		// the final node of a tree the one with domain 0x06
+		domain := byte(0x06)
+		if phase == kangarooSqueezing && len(b) > len(turboMagic)+1 && b[len(turboMagic)+1] == 0x07 {
+			domain = 0x07
+		}
+		sponge := k.newTurbo(domain)
+		if err := sponge.UnmarshalBinary(b); err != nil {
+			return err
+		}
+		if phase == kangarooTree {
+			final = sponge
+		} else {
+			out = sponge
+		}
+	default:
+		return errors.New("sha3: invalid hash state")
+	}
+	k.custom = append(k.custom[:0], custom...)
+	k.buf = append(k.buf[:0], buf...)
+	k.final, k.out, k.leaves = final, out, leaves
+	return nil
+}
+
+// cutLength splits a 4 bytes big-endian length prefixed field from b
+func cutLength(b []byte) (field, rest []byte, ok bool) {
+	if len(b) < 4 || uint64(binary.BigEndian.Uint32(b)) > uint64(len(b)-4) {
+		return nil, nil, false
+	}
+	n := int(binary.BigEndian.Uint32(b))
+	return b[4 : 4+n], b[4+n:], true
+}
@@ -2,6 +2,8 @@
 //Copyright (C) 2016 The Hyperchain Authors.
 package sha3
 
+import "hash"
+
 // spongeDirection indicates the direction bytes are flowing through the sponge.
 type spongeDirection int
 
@@ -44,6 +46,9 @@ type state struct {
 	fixedOutput bool            // whether this is a fixed-ouput-length instance
 	outputLen   int             // the default output size in bytes
 	state       spongeDirection // whether the sponge is absorbing or squeezing
+
+	// turbo selects the 12 rounds permutation of TurboSHAKE
+	turbo bool
 }
 
 // BlockSize returns the rate of sponge underlying this hash function.
@@ -68,12 +73,26 @@ func (d *state) clone() *state {
 	if ret.state == spongeAbsorbing {
 		ret.buf = ret.storage[:len(ret.buf)]
 	} else {
-		ret.buf = ret.storage[d.rate-cap(d.buf) : d.rate]
+		ret.buf = ret.storage[d.rate-len(d.buf) : d.rate]
 	}
 
 	return &ret
 }
 
+// Clone returns a copy of the hash that continues independently
+// from the current state.
+func (d *state) Clone() hash.Hash { return d.clone() }
+
+// keccak applies the permutation of the instance to the state, Keccak-f[1600]
+// or Keccak-p[1600, 12] for TurboSHAKE.
+func (d *state) keccak() {
+	if d.turbo {
+		keccakP12(&d.a)
+		return
+	}
+	keccakF1600(&d.a)
+}
+
 // permute applies the KeccakF-1600 permutation. It handles
 // any input-output buffering.
 func (d *state) permute() {
@@ -83,11 +102,11 @@ func (d *state) permute() {
 		// before applying the permutation.
 		xorIn(d, d.buf)
 		d.buf = d.storage[:0]
-		keccakF1600(&d.a)
+		d.keccak()
 	case spongeSqueezing:
 		// If we're squeezing, we need to apply the permutatin before
 		// copying more output.
-		keccakF1600(&d.a)
+		d.keccak()
 		d.buf = d.storage[:d.rate]
 		copyOut(d, d.buf)
 	}
@@ -136,7 +155,7 @@ func (d *state) Write(p []byte) (written int, err error) {
 			// The fast path; absorb a full "rate" bytes of input and apply the permutation.
 			xorIn(d, p[:d.rate])
 			p = p[d.rate:]
-			keccakF1600(&d.a)
+			d.keccak()
 		} else {
 			// The slow path; buffer the input until we can fill the sponge, and then xor it in.
 			todo := d.rate - len(d.buf)
@@ -187,16 +206,17 @@ func (d *state) Sum(in []byte) []byte {
 	// and summing.
 	dup := d.clone()
 
-	// save hash to in if there are enough space available, else allocate
-	// a new bytes to reside output
-	var out []byte
+	// append the hash to in, reusing its spare capacity if there is enough
+	// space available, else allocate a new bytes to reside output
+	n := len(in)
 	needed := dup.outputLen
-	if cap(in)-len(in) < needed {
-		out = make([]byte, dup.outputLen)
-	} else {
-		out = in[len(in) : len(in)+needed]
+	if cap(in)-n < needed {
+		grown := make([]byte, n, n+needed)
+		copy(grown, in)
+		in = grown
 	}
+	out := in[:n+needed]
 
-	_, _ = dup.Read(out)
+	_, _ = dup.Read(out[n:])
 	return out
 }
@@ -0,0 +1,40 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+package sha3
+
+import (
+	"hash"
+	"io"
+)
+
+// ShakeHash is a hash with arbitrary-length output. Read squeezes as many
+// bytes as needed, Write must not be called after the first Read.
+// Sum returns the first Size() bytes of output without changing the state.
+type ShakeHash interface {
+	hash.Hash
+	io.Reader
+}
+
+// NewShake128 creates a new SHAKE128 variable-output-length hash.
+// Its generic security strength is 128 bits against all attacks if at
+// least 32 bytes of its output are used.
+func NewShake128() ShakeHash { return &state{rate: 168, outputLen: 32, dsbyte: 0x1f} }
+
+// NewShake256 creates a new SHAKE256 variable-output-length hash.
+// Its generic security strength is 256 bits against all attacks if
+// at least 64 bytes of its output are used.
+func NewShake256() ShakeHash { return &state{rate: 136, outputLen: 64, dsbyte: 0x1f} }
+
+// ShakeSum128 writes an arbitrary-length digest of data into hash.
+func ShakeSum128(hash, data []byte) {
+	h := NewShake128()
+	_, _ = h.Write(data)
+	_, _ = h.Read(hash)
+}
+
+// ShakeSum256 writes an arbitrary-length digest of data into hash.
+func ShakeSum256(hash, data []byte) {
+	h := NewShake256()
+	_, _ = h.Write(data)
+	_, _ = h.Read(hash)
+}
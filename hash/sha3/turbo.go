@@ -0,0 +1,20 @@
+//Hyperchain License
+//Copyright (C) 2016 The Hyperchain Authors.
+package sha3
+
+// NewTurboShake128 creates a TurboSHAKE128 hash of RFC 9861, SHAKE128 with the
+// 12 rounds permutation Keccak-p[1600, 12]. domain is the domain separation
+// byte D, it must be in [0x01, 0x7F]; 0x1F is the default of the RFC.
+// Sum returns 32 bytes.
+func NewTurboShake128(domain byte) ShakeHash { return newTurboShake(168, 32, domain) }
+
+// NewTurboShake256 creates a TurboSHAKE256 hash of RFC 9861, see NewTurboShake128.
+// Sum returns 64 bytes.
+func NewTurboShake256(domain byte) ShakeHash { return newTurboShake(136, 64, domain) }
+
+func newTurboShake(rate, outputLen int, domain byte) *state {
+	if domain < 0x01 || domain > 0x7f {
+		panic("sha3: TurboSHAKE domain separation byte must be in [0x01, 0x7F]")
+	}
+	return &state{rate: rate, outputLen: outputLen, dsbyte: domain, turbo: true}
+}
@@ -0,0 +1,276 @@
+//Package lms implements the Leighton-Micali hash-based signatures of RFC 8554, LMS and
+// its multi-level variant HSS, with SHA-256. A key of L levels with heights h_i signs
+// 2^(h_0 + ... + h_(L-1)) messages. Signing is stateful, see the hashsig package.
+package lms
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/meshplus/crypto-standard/hashsig"
+)
+
+// maxLevels is the limit of RFC 8554 on the number of HSS levels
+const maxLevels = 8
+
+//error defines
+var (
+	ErrUnsupported       = errors.New("lms: unsupported parameter set")
+	ErrInvalidPublicKey  = errors.New("lms: invalid public key")
+	ErrInvalidPrivateKey = errors.New("lms: invalid private key")
+	ErrInvalidSignature  = errors.New("lms: invalid signature")
+)
+
+//Params is the parameter set of one level of HSS
+type Params struct {
+	LMS LMSType
+	OTS OTSType
+}
+
+//PublicKey a HSS public key
+type PublicKey struct {
+	levels uint32
+	top    *lmsPublicKey
+}
+
+//PrivateKey a HSS private key. It holds the secret seed of the top tree, the keys of the
+// lower trees are derived from it. The index of the next one-time key is not part of
+// the private key, it is kept by the hashsig.Store given to NewSigner.
+type PrivateKey struct {
+	params []Params
+	id     []byte
+	seed   []byte
+	root   []byte
+}
+
+//GenerateKey generate a HSS key with one level per element of params, from the top to the bottom.
+// It computes the whole top tree, which takes a while for heights of 15 and more.
+func GenerateKey(rand io.Reader, params ...Params) (*PrivateKey, error) {
+	if err := checkParams(params); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 16+n)
+	if _, err := io.ReadFull(rand, buf); err != nil {
+		return nil, err
+	}
+	key := &PrivateKey{params: append([]Params(nil), params...), id: buf[:16], seed: buf[16:]}
+	key.root = newLMSTree(newHasher(), params[0].LMS, params[0].OTS, key.id, key.seed).root
+	return key, nil
+}
+
+// checkParams checks the number of levels and the parameter sets
+func checkParams(params []Params) error {
+	if len(params) == 0 || len(params) > maxLevels {
+		return ErrUnsupported
+	}
+	height := 0
+	for _, p := range params {
+		if _, ok := lmsHeights[p.LMS]; !ok {
+			return ErrUnsupported
+		}
+		if _, ok := otsParamSets[p.OTS]; !ok {
+			return ErrUnsupported
+		}
+		height += lmsHeights[p.LMS]
+	}
+	// indices are uint64
+	if height > 64 {
+		return ErrUnsupported
+	}
+	return nil
+}
+
+//Public return the public key
+func (k *PrivateKey) Public() *PublicKey {
+	return &PublicKey{
+		levels: uint32(len(k.params)),
+		top:    &lmsPublicKey{typ: k.params[0].LMS, ots: k.params[0].OTS, id: k.id, root: k.root},
+	}
+}
+
+//Signatures return the number of messages the key can sign over its lifetime
+func (k *PrivateKey) Signatures() uint64 {
+	height := 0
+	for _, p := range k.params {
+		height += lmsHeights[p.LMS]
+	}
+	if height == 64 {
+		return ^uint64(0)
+	}
+	return 1 << uint(height)
+}
+
+//MarshalBinary encode the private key as u32str(L) || L * (u32str(lms type) || u32str(ots type)) || I || SEED || root
+func (k *PrivateKey) MarshalBinary() ([]byte, error) {
+	ret := make([]byte, 0, 4+8*len(k.params)+16+2*n)
+	ret = appendU32(ret, uint32(len(k.params)))
+	for _, p := range k.params {
+		ret = appendU32(ret, uint32(p.LMS))
+		ret = appendU32(ret, uint32(p.OTS))
+	}
+	ret = append(ret, k.id...)
+	ret = append(ret, k.seed...)
+	return append(ret, k.root...), nil
+}
+
+//ParsePrivateKey parse the output of PrivateKey.MarshalBinary
+func ParsePrivateKey(data []byte) (*PrivateKey, error) {
+	if len(data) < 4 {
+		return nil, ErrInvalidPrivateKey
+	}
+	levels := binary.BigEndian.Uint32(data)
+	if levels == 0 || levels > maxLevels || len(data) != 4+8*int(levels)+16+2*n {
+		return nil, ErrInvalidPrivateKey
+	}
+	data = append([]byte(nil), data[4:]...)
+	key := &PrivateKey{params: make([]Params, levels)}
+	for i := range key.params {
+		key.params[i] = Params{
+			LMS: LMSType(binary.BigEndian.Uint32(data[8*i:])),
+			OTS: OTSType(binary.BigEndian.Uint32(data[8*i+4:])),
+		}
+	}
+	if err := checkParams(key.params); err != nil {
+		return nil, err
+	}
+	data = data[8*levels:]
+	key.id, key.seed, key.root = data[:16], data[16:16+n], data[16+n:]
+	return key, nil
+}
+
+//Bytes encode the public key as u32str(L) || u32str(lms type) || u32str(ots type) || I || T[1]
+func (pub *PublicKey) Bytes() []byte {
+	return append(appendU32(nil, pub.levels), pub.top.bytes()...)
+}
+
+//ParsePublicKey parse a HSS public key
+func ParsePublicKey(data []byte) (*PublicKey, error) {
+	if len(data) != 4+lmsPublicKeyLen {
+		return nil, ErrInvalidPublicKey
+	}
+	levels := binary.BigEndian.Uint32(data)
+	if levels == 0 || levels > maxLevels {
+		return nil, ErrInvalidPublicKey
+	}
+	top, _, err := parseLMSPublicKey(append([]byte(nil), data[4:]...))
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKey{levels: levels, top: top}, nil
+}
+
+//Verify verify a HSS signature of msg, it implements algorithm 7 of RFC 8554
+func (pub *PublicKey) Verify(msg, sig []byte) error {
+	if len(sig) < 4 || binary.BigEndian.Uint32(sig)+1 != pub.levels {
+		return ErrInvalidSignature
+	}
+	sig = sig[4:]
+	h := newHasher()
+	key := pub.top
+	for i := uint32(0); i+1 < pub.levels; i++ {
+		l := sigLen(sig)
+		if l == 0 {
+			return ErrInvalidSignature
+		}
+		child, pl, err := parseLMSPublicKey(sig[l:])
+		if err != nil {
+			return ErrInvalidSignature
+		}
+		if !key.verify(h, sig[l:l+pl], sig[:l]) {
+			return ErrInvalidSignature
+		}
+		key, sig = child, sig[l+pl:]
+	}
+	if !key.verify(h, msg, sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+//Signer sign messages with a HSS private key. It keeps the trees on the path of the
+// current one-time key in memory, that is 2^(h+1) * 32 bytes per level. Signer is safe
+// for concurrent use, but a private key must never be used by two Signers at the same time.
+type Signer struct {
+	lock    sync.Mutex
+	key     *PrivateKey
+	counter *hashsig.Counter
+	h       *hasher
+	// trees[i] is the tree of level i signed by the one-time key paths[i-1] of trees[i-1],
+	// sigs[i] the signature of trees[i+1].
+	trees []*lmsTree
+	paths []uint32
+	sigs  [][]byte
+}
+
+//NewSigner return a Signer that persists the used one-time keys to store before using them,
+// batch is the number of indices reserved at once as in hashsig.NewCounter.
+// It recomputes the top tree and returns ErrInvalidPrivateKey if its root does not match.
+func NewSigner(key *PrivateKey, store hashsig.Store, batch uint64) (*Signer, error) {
+	counter, err := hashsig.NewCounter(store, key.Signatures(), batch)
+	if err != nil {
+		return nil, err
+	}
+	s := &Signer{
+		key:     key,
+		counter: counter,
+		h:       newHasher(),
+		trees:   make([]*lmsTree, len(key.params)),
+		paths:   make([]uint32, len(key.params)),
+		sigs:    make([][]byte, len(key.params)-1),
+	}
+	s.trees[0] = newLMSTree(s.h, key.params[0].LMS, key.params[0].OTS, key.id, key.seed)
+	if subtle.ConstantTimeCompare(s.trees[0].root, key.root) != 1 {
+		return nil, ErrInvalidPrivateKey
+	}
+	return s, nil
+}
+
+//Remaining return the number of messages that can still be signed
+func (s *Signer) Remaining() uint64 {
+	return s.counter.Remaining()
+}
+
+//Sign sign msg with the next one-time key, the index is persisted before the signature is computed.
+// It returns hashsig.ErrKeyExhausted once every one-time key is used.
+func (s *Signer) Sign(msg []byte) ([]byte, error) {
+	idx, err := s.counter.Next()
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// split idx into the one-time key of every level, the bottom level takes the low bits
+	qs := make([]uint32, len(s.key.params))
+	for i := len(qs) - 1; i >= 0; i-- {
+		height := uint(lmsHeights[s.key.params[i].LMS])
+		qs[i] = uint32(idx & (1<<height - 1))
+		idx >>= height
+	}
+	for i := 1; i < len(s.trees); i++ {
+		if s.trees[i] != nil && s.paths[i-1] == qs[i-1] {
+			continue
+		}
+		// the lower trees changed, later levels are rebuilt by the next iterations
+		for j := i; j < len(s.trees); j++ {
+			s.trees[j] = nil
+		}
+		parent := s.trees[i-1]
+		id, seed := parent.child(s.h, qs[i-1])
+		p := s.key.params[i]
+		s.trees[i] = newLMSTree(s.h, p.LMS, p.OTS, id, seed)
+		s.paths[i-1] = qs[i-1]
+		s.sigs[i-1] = parent.sign(s.h, qs[i-1], s.trees[i].bytes())
+	}
+
+	last := len(s.trees) - 1
+	ret := appendU32(nil, uint32(last))
+	for i := 0; i < last; i++ {
+		ret = append(ret, s.sigs[i]...)
+		ret = append(ret, s.trees[i+1].bytes()...)
+	}
+	return append(ret, s.trees[last].sign(s.h, qs[last], msg)...), nil
+}
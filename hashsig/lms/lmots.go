@@ -0,0 +1,179 @@
+package lms
+
+import (
+	"encoding/binary"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+//OTSType is the typecode of a LM-OTS parameter set
+type OTSType uint32
+
+//nolint
+const (
+	LMOTS_SHA256_N32_W1 OTSType = 1
+	LMOTS_SHA256_N32_W2 OTSType = 2
+	LMOTS_SHA256_N32_W4 OTSType = 3
+	LMOTS_SHA256_N32_W8 OTSType = 4
+)
+
+// domain separation of RFC 8554 section 7.1, and of the pseudorandom
+// values derived from a SEED which use 0xff in place of a chain step.
+const (
+	dPBLC   = 0x8080
+	dMESG   = 0x8181
+	dLEAF   = 0x8282
+	dINTR   = 0x8383
+	dRAND   = 0xfffd
+	dSEED   = 0xfffe
+	dCHILDI = 0xffff
+)
+
+// n is the output length of SHA-256, the only hash of RFC 8554
+const n = 32
+
+// otsParams are the parameters of table 1 of RFC 8554
+type otsParams struct {
+	w, p, ls int
+}
+
+var otsParamSets = map[OTSType]*otsParams{
+	LMOTS_SHA256_N32_W1: {w: 1, p: 265, ls: 7},
+	LMOTS_SHA256_N32_W2: {w: 2, p: 133, ls: 6},
+	LMOTS_SHA256_N32_W4: {w: 4, p: 67, ls: 4},
+	LMOTS_SHA256_N32_W8: {w: 8, p: 34, ls: 0},
+}
+
+// sigLen returns the length of a LM-OTS signature
+func (op *otsParams) sigLen() int {
+	return 4 + n + op.p*n
+}
+
+// coef returns the i-th w-bit value of s
+func coef(s []byte, i, w int) int {
+	return int(s[i*w/8]>>(8-(w*(i%(8/w))+w))) & (1<<w - 1)
+}
+
+// checksum is the checksum of algorithm 2 of RFC 8554
+func (op *otsParams) checksum(q []byte) uint16 {
+	sum := 0
+	for i := 0; i < n*8/op.w; i++ {
+		sum += 1<<op.w - 1 - coef(q, i, op.w)
+	}
+	return uint16(sum << op.ls)
+}
+
+// hasher computes H(I || u32str(q) || ...) with one SHA-256 Hasher, it is not safe for concurrent use
+type hasher struct {
+	h   *hash.Hasher
+	buf []byte
+}
+
+func newHasher() *hasher {
+	return &hasher{h: hash.NewHasher(hash.SHA2_256), buf: make([]byte, 0, 128)}
+}
+
+// prefix starts the buffer with I || u32str(q)
+func (h *hasher) prefix(id []byte, q uint32) {
+	h.buf = append(h.buf[:0], id...)
+	h.buf = appendU32(h.buf, q)
+}
+
+// sum hashes the buffer into out
+func (h *hasher) sum(out []byte) []byte {
+	ret, _ := h.h.HashBuffer(h.buf, out[:0])
+	return ret
+}
+
+// chain applies the steps [from, to) of chain i to tmp in place
+func (h *hasher) chain(id []byte, q uint32, i int, tmp []byte, from, to int) {
+	for j := from; j < to; j++ {
+		h.prefix(id, q)
+		h.buf = appendU16(h.buf, uint16(i))
+		h.buf = append(h.buf, byte(j))
+		h.buf = append(h.buf, tmp...)
+		h.sum(tmp)
+	}
+}
+
+// derive returns H(I || u32str(q) || u16str(i) || u8str(0xff) || SEED), the pseudorandom
+// derivation of appendix A of RFC 8554
+func (h *hasher) derive(id []byte, q uint32, i uint16, seed []byte) []byte {
+	h.prefix(id, q)
+	h.buf = appendU16(h.buf, i)
+	h.buf = append(h.buf, 0xff)
+	h.buf = append(h.buf, seed...)
+	return h.sum(make([]byte, n))
+}
+
+// otsPublicKey returns K, the hash of the public key of the one-time key q
+func (h *hasher) otsPublicKey(op *otsParams, id []byte, q uint32, seed []byte) []byte {
+	y := make([]byte, 0, op.p*n)
+	for i := 0; i < op.p; i++ {
+		x := h.derive(id, q, uint16(i), seed)
+		h.chain(id, q, i, x, 0, 1<<op.w-1)
+		y = append(y, x...)
+	}
+	h.prefix(id, q)
+	h.buf = appendU16(h.buf, dPBLC)
+	h.buf = append(h.buf, y...)
+	return h.sum(make([]byte, n))
+}
+
+// messageHash returns Q || Cksm(Q)
+func (h *hasher) messageHash(op *otsParams, id []byte, q uint32, c, msg []byte) []byte {
+	h.prefix(id, q)
+	h.buf = appendU16(h.buf, dMESG)
+	h.buf = append(h.buf, c...)
+	h.buf = append(h.buf, msg...)
+	sum := h.sum(make([]byte, n, n+2))
+	return appendU16(sum, op.checksum(sum))
+}
+
+// otsSign signs msg with the one-time key q, the randomizer C is derived from SEED, so signing
+// the same message again after a restart gives the same signature and reveals nothing new.
+func (h *hasher) otsSign(typ OTSType, id []byte, q uint32, seed, msg []byte) []byte {
+	op := otsParamSets[typ]
+	sig := make([]byte, 0, op.sigLen())
+	sig = appendU32(sig, uint32(typ))
+	c := h.derive(id, q, dRAND, seed)
+	sig = append(sig, c...)
+	qc := h.messageHash(op, id, q, c, msg)
+	for i := 0; i < op.p; i++ {
+		y := h.derive(id, q, uint16(i), seed)
+		h.chain(id, q, i, y, 0, coef(qc, i, op.w))
+		sig = append(sig, y...)
+	}
+	return sig
+}
+
+// otsPublicKeyFromSig implements algorithm 4b of RFC 8554, it returns nil if sig is malformed
+func (h *hasher) otsPublicKeyFromSig(typ OTSType, id []byte, q uint32, sig, msg []byte) []byte {
+	op := otsParamSets[typ]
+	if len(sig) != op.sigLen() || OTSType(binary.BigEndian.Uint32(sig)) != typ {
+		return nil
+	}
+	c, y := sig[4:4+n], sig[4+n:]
+	qc := h.messageHash(op, id, q, c, msg)
+	z := make([]byte, 0, op.p*n)
+	tmp := make([]byte, n)
+	for i := 0; i < op.p; i++ {
+		copy(tmp, y[i*n:])
+		h.chain(id, q, i, tmp, coef(qc, i, op.w), 1<<op.w-1)
+		z = append(z, tmp...)
+	}
+	h.prefix(id, q)
+	h.buf = appendU16(h.buf, dPBLC)
+	h.buf = append(h.buf, z...)
+	return h.sum(make([]byte, n))
+}
+
+// appendU32 appends u32str(v)
+func appendU32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// appendU16 appends u16str(v)
+func appendU16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
@@ -0,0 +1,190 @@
+package lms
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+)
+
+//LMSType is the typecode of a LMS parameter set
+type LMSType uint32
+
+//nolint
+const (
+	LMS_SHA256_M32_H5  LMSType = 5
+	LMS_SHA256_M32_H10 LMSType = 6
+	LMS_SHA256_M32_H15 LMSType = 7
+	LMS_SHA256_M32_H20 LMSType = 8
+	LMS_SHA256_M32_H25 LMSType = 9
+)
+
+// lmsHeights are the tree heights of table 2 of RFC 8554
+var lmsHeights = map[LMSType]int{
+	LMS_SHA256_M32_H5:  5,
+	LMS_SHA256_M32_H10: 10,
+	LMS_SHA256_M32_H15: 15,
+	LMS_SHA256_M32_H20: 20,
+	LMS_SHA256_M32_H25: 25,
+}
+
+// lmsPublicKeyLen is the length of u32str(type) || u32str(otstype) || I || T[1]
+const lmsPublicKeyLen = 4 + 4 + 16 + n
+
+// lmsSigLen returns the length of a LMS signature
+func lmsSigLen(typ LMSType, ots OTSType) int {
+	return 4 + otsParamSets[ots].sigLen() + 4 + lmsHeights[typ]*n
+}
+
+// lmsPublicKey is the public key of one LMS tree
+type lmsPublicKey struct {
+	typ  LMSType
+	ots  OTSType
+	id   []byte
+	root []byte
+}
+
+// parseLMSPublicKey parses the public key at the start of data and returns it with its length
+func parseLMSPublicKey(data []byte) (*lmsPublicKey, int, error) {
+	if len(data) < 8 {
+		return nil, 0, ErrInvalidPublicKey
+	}
+	pub := &lmsPublicKey{
+		typ: LMSType(binary.BigEndian.Uint32(data)),
+		ots: OTSType(binary.BigEndian.Uint32(data[4:])),
+	}
+	if _, ok := lmsHeights[pub.typ]; !ok {
+		return nil, 0, ErrUnsupported
+	}
+	if _, ok := otsParamSets[pub.ots]; !ok {
+		return nil, 0, ErrUnsupported
+	}
+	if len(data) < lmsPublicKeyLen {
+		return nil, 0, ErrInvalidPublicKey
+	}
+	pub.id = data[8:24]
+	pub.root = data[24:lmsPublicKeyLen]
+	return pub, lmsPublicKeyLen, nil
+}
+
+// bytes returns the encoding of pub
+func (pub *lmsPublicKey) bytes() []byte {
+	ret := make([]byte, 0, lmsPublicKeyLen)
+	ret = appendU32(ret, uint32(pub.typ))
+	ret = appendU32(ret, uint32(pub.ots))
+	ret = append(ret, pub.id...)
+	return append(ret, pub.root...)
+}
+
+// sigLen returns the length of the signature at the start of sig, or 0 if the types are unknown
+func sigLen(sig []byte) int {
+	if len(sig) < 8 {
+		return 0
+	}
+	op, ok := otsParamSets[OTSType(binary.BigEndian.Uint32(sig[4:]))]
+	if !ok || len(sig) < 4+op.sigLen()+4 {
+		return 0
+	}
+	h, ok := lmsHeights[LMSType(binary.BigEndian.Uint32(sig[4+op.sigLen():]))]
+	if !ok {
+		return 0
+	}
+	return 4 + op.sigLen() + 4 + h*n
+}
+
+// verify implements algorithm 6a of RFC 8554, sig must be exactly one LMS signature
+func (pub *lmsPublicKey) verify(h *hasher, msg, sig []byte) bool {
+	height := lmsHeights[pub.typ]
+	if len(sig) != lmsSigLen(pub.typ, pub.ots) {
+		return false
+	}
+	q := binary.BigEndian.Uint32(sig)
+	otsLen := otsParamSets[pub.ots].sigLen()
+	if q >= 1<<uint(height) || LMSType(binary.BigEndian.Uint32(sig[4+otsLen:])) != pub.typ {
+		return false
+	}
+	k := h.otsPublicKeyFromSig(pub.ots, pub.id, q, sig[4:4+otsLen], msg)
+	if k == nil {
+		return false
+	}
+	path := sig[4+otsLen+4:]
+	node := uint32(1)<<uint(height) + q
+	h.prefix(pub.id, node)
+	h.buf = appendU16(h.buf, dLEAF)
+	h.buf = append(h.buf, k...)
+	tmp := h.sum(make([]byte, n))
+	for i := 0; node > 1; i++ {
+		h.prefix(pub.id, node/2)
+		h.buf = appendU16(h.buf, dINTR)
+		if node&1 == 1 {
+			h.buf = append(h.buf, path[i*n:(i+1)*n]...)
+			h.buf = append(h.buf, tmp...)
+		} else {
+			h.buf = append(h.buf, tmp...)
+			h.buf = append(h.buf, path[i*n:(i+1)*n]...)
+		}
+		h.sum(tmp)
+		node /= 2
+	}
+	return subtle.ConstantTimeCompare(tmp, pub.root) == 1
+}
+
+// lmsTree is a LMS private key with all the nodes of its tree
+type lmsTree struct {
+	lmsPublicKey
+	seed []byte
+	// nodes holds T[r] at nodes[r*n:], r in [1, 2^(h+1))
+	nodes []byte
+}
+
+// newLMSTree derives the leaves of the tree I, SEED as in appendix A of RFC 8554 and
+// computes the nodes. It takes 2^h one-time public keys, so it is slow for high trees.
+func newLMSTree(h *hasher, typ LMSType, ots OTSType, id, seed []byte) *lmsTree {
+	height := lmsHeights[typ]
+	op := otsParamSets[ots]
+	leaves := uint32(1) << uint(height)
+	t := &lmsTree{
+		lmsPublicKey: lmsPublicKey{typ: typ, ots: ots, id: id},
+		seed:         seed,
+		nodes:        make([]byte, 2*int(leaves)*n),
+	}
+	for q := uint32(0); q < leaves; q++ {
+		k := h.otsPublicKey(op, id, q, seed)
+		r := leaves + q
+		h.prefix(id, r)
+		h.buf = appendU16(h.buf, dLEAF)
+		h.buf = append(h.buf, k...)
+		h.sum(t.node(r))
+	}
+	for r := leaves - 1; r >= 1; r-- {
+		h.prefix(id, r)
+		h.buf = appendU16(h.buf, dINTR)
+		h.buf = append(h.buf, t.node(2*r)...)
+		h.buf = append(h.buf, t.node(2*r+1)...)
+		h.sum(t.node(r))
+	}
+	t.root = t.node(1)
+	return t
+}
+
+// node returns T[r]
+func (t *lmsTree) node(r uint32) []byte {
+	return t.nodes[int(r)*n : int(r+1)*n]
+}
+
+// sign signs msg with the one-time key q
+func (t *lmsTree) sign(h *hasher, q uint32, msg []byte) []byte {
+	height := lmsHeights[t.typ]
+	sig := make([]byte, 0, lmsSigLen(t.typ, t.ots))
+	sig = appendU32(sig, q)
+	sig = append(sig, h.otsSign(t.ots, t.id, q, t.seed, msg)...)
+	sig = appendU32(sig, uint32(t.typ))
+	r := uint32(1)<<uint(height) + q
+	for i := 0; i < height; i++ {
+		sig = append(sig, t.node((r>>uint(i))^1)...)
+	}
+	return sig
+}
+
+// child derives I and SEED of the tree signed by the one-time key q
+func (t *lmsTree) child(h *hasher, q uint32) (id, seed []byte) {
+	return h.derive(t.id, q, dCHILDI, t.seed)[:16], h.derive(t.id, q, dSEED, t.seed)
+}
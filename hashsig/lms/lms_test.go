@@ -0,0 +1,160 @@
+package lms
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/hashsig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRFC8554Keys(t *testing.T) {
+	// the private keys of test case 2 of RFC 8554 appendix F
+	cases := []struct {
+		typ      LMSType
+		ots      OTSType
+		id, seed string
+		root     string
+	}{
+		{LMS_SHA256_M32_H10, LMOTS_SHA256_N32_W4, "d08fabd4a2091ff0a8cb4ed834e74534",
+			"558b8966c48ae9cb898b423c83443aae014a72f1b1ab5cc85cf1d892903b5439",
+			"32a58885cd9ba0431235466bff9651c6c92124404d45fa53cf161c28f1ad5a8e"},
+		{LMS_SHA256_M32_H5, LMOTS_SHA256_N32_W8, "215f83b7ccb9acbcd08db97b0d04dc2b",
+			"a1c4696e2608035a886100d05cd99945eb3370731884a8235e2fb3d4d71f2547",
+			"a1cd035833e0e90059603f26e07ad2aad152338e7a5e5984bcd5f7bb4eba40b7"},
+	}
+	h := newHasher()
+	for _, c := range cases {
+		id, _ := hex.DecodeString(c.id)
+		seed, _ := hex.DecodeString(c.seed)
+		tree := newLMSTree(h, c.typ, c.ots, id, seed)
+		assert.Equal(t, c.root, hex.EncodeToString(tree.root))
+
+		msg := []byte("The enumeration in the Constitution, of certain rights, shall not be construed to deny or disparage others retained by the people.\n")
+		sig := tree.sign(h, 4, msg)
+		assert.True(t, tree.lmsPublicKey.verify(h, msg, sig))
+		assert.False(t, tree.lmsPublicKey.verify(h, msg[1:], sig))
+	}
+}
+
+func TestRFC8554Signature(t *testing.T) {
+	// the HSS public key of test case 2 of RFC 8554 appendix F,
+	// u32str(L) || u32str(lms type) || u32str(ots type) || I || T[1]
+	data, _ := hex.DecodeString("00000002" + "00000006" + "00000003" + "d08fabd4a2091ff0a8cb4ed834e74534" +
+		"32a58885cd9ba0431235466bff9651c6c92124404d45fa53cf161c28f1ad5a8e")
+	pub, err := ParsePublicKey(data)
+	assert.Nil(t, err)
+	assert.Equal(t, data, pub.Bytes())
+
+	h := newHasher()
+	id, _ := hex.DecodeString("d08fabd4a2091ff0a8cb4ed834e74534")
+	seed, _ := hex.DecodeString("558b8966c48ae9cb898b423c83443aae014a72f1b1ab5cc85cf1d892903b5439")
+	top := newLMSTree(h, LMS_SHA256_M32_H10, LMOTS_SHA256_N32_W4, id, seed)
+	id, _ = hex.DecodeString("215f83b7ccb9acbcd08db97b0d04dc2b")
+	seed, _ = hex.DecodeString("a1c4696e2608035a886100d05cd99945eb3370731884a8235e2fb3d4d71f2547")
+	bottom := newLMSTree(h, LMS_SHA256_M32_H5, LMOTS_SHA256_N32_W8, id, seed)
+
+	// u32str(Nspk) || signed_pub_key[0] || pub[1] || sig[1] as in section 6.2
+	msg := []byte("The enumeration in the Constitution, of certain rights, shall not be construed to deny or disparage others retained by the people.\n")
+	child := bottom.lmsPublicKey.bytes()
+	topSig := top.sign(h, 4, child)
+	bottomSig := bottom.sign(h, 5, msg)
+	sig := appendU32(nil, 1)
+	sig = append(sig, topSig...)
+	sig = append(sig, child...)
+	sig = append(sig, bottomSig...)
+
+	// the lengths of sections 4.5 and 5.4: 4 + (4 + n + p*n) + 4 + h*n
+	assert.Equal(t, 4+(4+32+67*32)+4+10*32, len(topSig))
+	assert.Equal(t, 4+(4+32+34*32)+4+5*32, len(bottomSig))
+	assert.Equal(t, "00000004"+"00000003", hex.EncodeToString(topSig[:8]))
+	assert.Equal(t, "00000006", hex.EncodeToString(topSig[4+4+32+67*32:][:4]))
+	assert.Equal(t, "00000005"+"00000004"+"215f83b7ccb9acbcd08db97b0d04dc2b", hex.EncodeToString(child[:24]))
+	assert.Equal(t, "00000005"+"00000004", hex.EncodeToString(bottomSig[:8]))
+
+	assert.Nil(t, pub.Verify(msg, sig))
+	assert.Equal(t, ErrInvalidSignature, pub.Verify(msg[1:], sig))
+	sig[4+8] ^= 1
+	assert.Equal(t, ErrInvalidSignature, pub.Verify(msg, sig))
+}
+
+func TestHSS(t *testing.T) {
+	key, err := GenerateKey(rand.Reader, Params{LMS_SHA256_M32_H5, LMOTS_SHA256_N32_W4}, Params{LMS_SHA256_M32_H5, LMOTS_SHA256_N32_W8})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1024), key.Signatures())
+	pub, err := ParsePublicKey(key.Public().Bytes())
+	assert.Nil(t, err)
+
+	data, err := key.MarshalBinary()
+	assert.Nil(t, err)
+	parsed, err := ParsePrivateKey(data)
+	assert.Nil(t, err)
+	assert.Equal(t, key, parsed)
+
+	signer, err := NewSigner(parsed, new(hashsig.MemoryStore), 0)
+	assert.Nil(t, err)
+	// 40 signatures cross the boundary of the first bottom tree
+	for i := 0; i < 40; i++ {
+		msg := []byte{byte(i)}
+		sig, err := signer.Sign(msg)
+		assert.Nil(t, err)
+		assert.Nil(t, pub.Verify(msg, sig))
+		assert.Equal(t, ErrInvalidSignature, pub.Verify([]byte{byte(i + 1)}, sig))
+		sig[len(sig)-1] ^= 1
+		assert.Equal(t, ErrInvalidSignature, pub.Verify(msg, sig))
+		sig[len(sig)-1] ^= 1
+		assert.Equal(t, ErrInvalidSignature, pub.Verify(msg, sig[:len(sig)-1]))
+	}
+	assert.Equal(t, uint64(1024-40), signer.Remaining())
+
+	_, err = GenerateKey(rand.Reader)
+	assert.Equal(t, ErrUnsupported, err)
+	_, err = GenerateKey(rand.Reader, Params{LMS_SHA256_M32_H5, OTSType(5)})
+	assert.Equal(t, ErrUnsupported, err)
+	data[len(data)-1] ^= 1
+	parsed, _ = ParsePrivateKey(data)
+	_, err = NewSigner(parsed, new(hashsig.MemoryStore), 0)
+	assert.Equal(t, ErrInvalidPrivateKey, err)
+}
+
+func TestSignerState(t *testing.T) {
+	key, err := GenerateKey(rand.Reader, Params{LMS_SHA256_M32_H5, LMOTS_SHA256_N32_W8})
+	assert.Nil(t, err)
+	store := hashsig.NewFileStore(filepath.Join(t.TempDir(), "lms.state"))
+	signer, err := NewSigner(key, store, 8)
+	assert.Nil(t, err)
+	seen := make(map[uint32]bool)
+	q := func(sig []byte) uint32 {
+		// u32str(Nspk) || u32str(q) || ...
+		return binary.BigEndian.Uint32(sig[4:])
+	}
+	for i := 0; i < 3; i++ {
+		sig, err := signer.Sign([]byte("msg"))
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(i), q(sig))
+		seen[q(sig)] = true
+	}
+	next, err := store.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(8), next)
+
+	// a restart skips the reserved indices
+	signer, err = NewSigner(key, store, 8)
+	assert.Nil(t, err)
+	var last []byte
+	for i := 8; i < 32; i++ {
+		sig, err := signer.Sign([]byte("msg"))
+		assert.Nil(t, err)
+		assert.False(t, seen[q(sig)])
+		assert.Equal(t, uint32(i), q(sig))
+		assert.False(t, bytes.Equal(last, sig))
+		last = sig
+	}
+	_, err = signer.Sign([]byte("msg"))
+	assert.Equal(t, hashsig.ErrKeyExhausted, err)
+	assert.Equal(t, uint64(0), signer.Remaining())
+}
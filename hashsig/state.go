@@ -0,0 +1,169 @@
+//Package hashsig holds the state handling shared by the stateful hash-based signatures
+// of its sub packages, xmss (RFC 8391) and lms (RFC 8554).
+//
+// Every signature uses a one-time key chosen by an index, signing twice with the same index
+// reveals enough of the one-time key to forge signatures. The index must therefore be
+// durably advanced before a signature leaves the signer: Counter persists it to a Store first,
+// so after a crash the signer resumes from the persisted value and at worst skips some keys.
+package hashsig
+
+import (
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+//error defines
+var (
+	ErrKeyExhausted = errors.New("hashsig: all one-time keys are used")
+	ErrInvalidState = errors.New("hashsig: invalid persisted state")
+)
+
+//Store persists the index of the next unused one-time key of a private key
+type Store interface {
+	//Load return the persisted index, 0 if nothing was persisted yet
+	Load() (uint64, error)
+	//Save persist next, it must not return before the value survives a crash
+	Save(next uint64) error
+}
+
+//MemoryStore a Store kept in memory, for tests and keys that never outlive the process
+type MemoryStore struct {
+	lock sync.Mutex
+	next uint64
+}
+
+//Load return the saved index
+func (s *MemoryStore) Load() (uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.next, nil
+}
+
+//Save record next
+func (s *MemoryStore) Save(next uint64) error {
+	s.lock.Lock()
+	s.next = next
+	s.lock.Unlock()
+	return nil
+}
+
+//FileStore a Store kept in a file. Save writes a temporary file, syncs it and renames it over
+// the old one, so the file always holds either the old or the new index.
+type FileStore struct {
+	path string
+}
+
+//NewFileStore return a FileStore of path, the file is created by the first Save
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+//Load read the index, 0 if the file does not exist
+func (s *FileStore) Load() (uint64, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(data) != 8 {
+		return 0, ErrInvalidState
+	}
+	return binary.BigEndian.Uint64(data), nil
+}
+
+//Save replace the file with next
+func (s *FileStore) Save(next uint64) error {
+	var data [8]byte
+	binary.BigEndian.PutUint64(data[:], next)
+	dir := filepath.Dir(s.path)
+	f, err := ioutil.TempFile(dir, filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err = f.Write(data[:]); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, s.path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	// make the rename durable
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+//Counter hand out the indices of one-time keys. An index is persisted as used before it is
+// returned, indices are reserved by batches to save writes, a crash loses the unused part
+// of the last batch but never hands out an index twice. Counter is safe for concurrent use.
+type Counter struct {
+	lock  sync.Mutex
+	store Store
+	// next is the next index to hand out, reserved the persisted bound
+	next, reserved uint64
+	limit, batch   uint64
+}
+
+//NewCounter return a Counter of the indices [persisted, limit) of store. batch is the number
+// of indices reserved by one Save, 0 or 1 persists every index.
+func NewCounter(store Store, limit, batch uint64) (*Counter, error) {
+	next, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if next > limit {
+		return nil, ErrInvalidState
+	}
+	if batch == 0 {
+		batch = 1
+	}
+	return &Counter{store: store, next: next, reserved: next, limit: limit, batch: batch}, nil
+}
+
+//Next return an unused index, it returns ErrKeyExhausted once all indices are used
+func (c *Counter) Next() (uint64, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.next >= c.limit {
+		return 0, ErrKeyExhausted
+	}
+	if c.next >= c.reserved {
+		reserved := c.next + c.batch
+		if reserved > c.limit || reserved < c.next {
+			reserved = c.limit
+		}
+		if err := c.store.Save(reserved); err != nil {
+			return 0, err
+		}
+		c.reserved = reserved
+	}
+	idx := c.next
+	c.next++
+	return idx, nil
+}
+
+//Remaining return the number of indices that can still be handed out
+func (c *Counter) Remaining() uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.limit - c.next
+}
@@ -0,0 +1,81 @@
+package hashsig
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.state")
+	store := NewFileStore(path)
+	next, err := store.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), next)
+
+	assert.Nil(t, store.Save(1<<40+5))
+	next, err = NewFileStore(path).Load()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1<<40+5), next)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte{1, 2, 3}, 0600))
+	_, err = store.Load()
+	assert.Equal(t, ErrInvalidState, err)
+	_, err = NewCounter(store, 10, 1)
+	assert.Equal(t, ErrInvalidState, err)
+}
+
+func TestCounter(t *testing.T) {
+	store := new(MemoryStore)
+	c, err := NewCounter(store, 100, 10)
+	assert.Nil(t, err)
+	seen := make(map[uint64]bool)
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				idx, err := c.Next()
+				assert.Nil(t, err)
+				lock.Lock()
+				assert.False(t, seen[idx])
+				seen[idx] = true
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(60), c.Remaining())
+	next, _ := store.Load()
+	assert.Equal(t, uint64(40), next)
+
+	// a crash loses the rest of the batch, never reuses an index
+	idx, _ := c.Next()
+	assert.Equal(t, uint64(40), idx)
+	c, err = NewCounter(store, 100, 10)
+	assert.Nil(t, err)
+	idx, _ = c.Next()
+	assert.Equal(t, uint64(50), idx)
+
+	// the last batch is cut at the limit
+	store = new(MemoryStore)
+	assert.Nil(t, store.Save(50))
+	c, err = NewCounter(store, 55, 10)
+	assert.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = c.Next()
+		assert.Nil(t, err)
+	}
+	_, err = c.Next()
+	assert.Equal(t, ErrKeyExhausted, err)
+	next, _ = store.Load()
+	assert.Equal(t, uint64(55), next)
+
+	_, err = NewCounter(store, 54, 10)
+	assert.Equal(t, ErrInvalidState, err)
+}
@@ -0,0 +1,164 @@
+package xmss
+
+import (
+	"encoding/binary"
+	stdhash "hash"
+)
+
+// padding values of the hash functions of RFC 8391 section 5.1, and of
+// PRF_keygen of NIST SP 800-208
+const (
+	padF         = 0
+	padH         = 1
+	padHMsg      = 2
+	padPRF       = 3
+	padPRFKeygen = 4
+)
+
+// address types of RFC 8391 section 2.5
+const (
+	addrOTS   = 0
+	addrLTree = 1
+	addrTree  = 2
+)
+
+// address is the 32 bytes hash function address ADRS
+type address [8]uint32
+
+func (a *address) setLayer(layer uint32) { a[0] = layer }
+
+func (a *address) setTree(tree uint64) {
+	a[1], a[2] = uint32(tree>>32), uint32(tree)
+}
+
+// setType sets the type and clears the words that follow it
+func (a *address) setType(typ uint32) {
+	a[3] = typ
+	a[4], a[5], a[6], a[7] = 0, 0, 0, 0
+}
+
+// setOTS sets the OTS address of an OTS hash address and the L-tree address of a L-tree address
+func (a *address) setOTS(i uint32) { a[4] = i }
+
+func (a *address) setChain(i uint32) { a[5] = i }
+
+func (a *address) setHash(i uint32) { a[6] = i }
+
+func (a *address) setTreeHeight(i uint32) { a[5] = i }
+
+func (a *address) setTreeIndex(i uint32) { a[6] = i }
+
+func (a *address) setKeyAndMask(i uint32) { a[7] = i }
+
+func (a *address) bytes(b *[32]byte) []byte {
+	for i, v := range a {
+		binary.BigEndian.PutUint32(b[4*i:], v)
+	}
+	return b[:]
+}
+
+// hasher computes the keyed hash functions of a parameter set, it is not safe for concurrent use
+type hasher struct {
+	n    int
+	h    stdhash.Hash
+	buf  []byte
+	adrs [32]byte
+	// scratch for keys and bitmasks
+	key, bm0, bm1 []byte
+}
+
+func newHasher(p *params) *hasher {
+	return &hasher{
+		n:   p.n,
+		h:   p.newHash(),
+		buf: make([]byte, 0, 4*p.n+32),
+		key: make([]byte, p.n),
+		bm0: make([]byte, p.n),
+		bm1: make([]byte, p.n),
+	}
+}
+
+// start begins toByte(pad, n) || key in the buffer
+func (h *hasher) start(pad byte, key []byte) {
+	h.buf = h.buf[:h.n]
+	for i := range h.buf {
+		h.buf[i] = 0
+	}
+	h.buf[h.n-1] = pad
+	h.buf = append(h.buf, key...)
+}
+
+// finish hashes the buffer followed by tail into out[:n]
+func (h *hasher) finish(out, tail []byte) {
+	h.h.Reset()
+	_, _ = h.h.Write(h.buf)
+	_, _ = h.h.Write(tail)
+	h.h.Sum(out[:0])
+}
+
+// prf computes PRF(key, adrs) into out
+func (h *hasher) prf(out, key []byte, a *address) {
+	h.start(padPRF, key)
+	h.finish(out, a.bytes(&h.adrs))
+}
+
+// randomizer computes r = PRF(SK_PRF, toByte(idx, 32)) of a signature
+func (h *hasher) randomizer(skPRF []byte, idx uint64) []byte {
+	var m [32]byte
+	binary.BigEndian.PutUint64(m[24:], idx)
+	h.start(padPRF, skPRF)
+	out := make([]byte, h.n)
+	h.finish(out, m[:])
+	return out
+}
+
+// prfKeygen computes PRF_keygen(skSeed, pubSeed || adrs) into out
+func (h *hasher) prfKeygen(out, skSeed, pubSeed []byte, a *address) {
+	h.start(padPRFKeygen, skSeed)
+	h.buf = append(h.buf, pubSeed...)
+	h.buf = append(h.buf, a.bytes(&h.adrs)...)
+	h.finish(out, nil)
+}
+
+// hashMsg computes H_msg(r || root || toByte(idx, n), msg)
+func (h *hasher) hashMsg(r, root []byte, idx uint64, msg []byte) []byte {
+	h.start(padHMsg, r)
+	h.buf = append(h.buf, root...)
+	h.buf = append(h.buf, make([]byte, h.n-8)...)
+	h.buf = append(h.buf, byte(idx>>56), byte(idx>>48), byte(idx>>40), byte(idx>>32),
+		byte(idx>>24), byte(idx>>16), byte(idx>>8), byte(idx))
+	out := make([]byte, h.n)
+	h.finish(out, msg)
+	return out
+}
+
+// f computes F(KEY, x XOR BM) in place, the key and the bitmask are derived from pubSeed and a
+func (h *hasher) f(x, pubSeed []byte, a *address) {
+	a.setKeyAndMask(0)
+	h.prf(h.key, pubSeed, a)
+	a.setKeyAndMask(1)
+	h.prf(h.bm0, pubSeed, a)
+	h.start(padF, h.key)
+	for i := range x {
+		h.buf = append(h.buf, x[i]^h.bm0[i])
+	}
+	h.finish(x, nil)
+}
+
+// randHash computes RAND_HASH(left, right, SEED, ADRS) of RFC 8391 algorithm 7 into out
+func (h *hasher) randHash(out, left, right, pubSeed []byte, a *address) {
+	a.setKeyAndMask(0)
+	h.prf(h.key, pubSeed, a)
+	a.setKeyAndMask(1)
+	h.prf(h.bm0, pubSeed, a)
+	a.setKeyAndMask(2)
+	h.prf(h.bm1, pubSeed, a)
+	h.start(padH, h.key)
+	for i := range left {
+		h.buf = append(h.buf, left[i]^h.bm0[i])
+	}
+	for i := range right {
+		h.buf = append(h.buf, right[i]^h.bm1[i])
+	}
+	h.finish(out, nil)
+}
@@ -0,0 +1,160 @@
+package xmss
+
+import (
+	stdhash "hash"
+
+	"github.com/meshplus/crypto-standard/hash"
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+)
+
+//Type is the OID of a XMSS parameter set, RFC 8391 section 5.3
+type Type uint32
+
+//MTType is the OID of a XMSS^MT parameter set, RFC 8391 section 5.4
+type MTType uint32
+
+//nolint
+const (
+	XMSS_SHA2_10_256  Type = 0x01
+	XMSS_SHA2_16_256  Type = 0x02
+	XMSS_SHA2_20_256  Type = 0x03
+	XMSS_SHA2_10_512  Type = 0x04
+	XMSS_SHA2_16_512  Type = 0x05
+	XMSS_SHA2_20_512  Type = 0x06
+	XMSS_SHAKE_10_256 Type = 0x07
+	XMSS_SHAKE_16_256 Type = 0x08
+	XMSS_SHAKE_20_256 Type = 0x09
+	XMSS_SHAKE_10_512 Type = 0x0a
+	XMSS_SHAKE_16_512 Type = 0x0b
+	XMSS_SHAKE_20_512 Type = 0x0c
+)
+
+//nolint
+const (
+	XMSSMT_SHA2_20_2_256   MTType = 0x01
+	XMSSMT_SHA2_20_4_256   MTType = 0x02
+	XMSSMT_SHA2_40_2_256   MTType = 0x03
+	XMSSMT_SHA2_40_4_256   MTType = 0x04
+	XMSSMT_SHA2_40_8_256   MTType = 0x05
+	XMSSMT_SHA2_60_3_256   MTType = 0x06
+	XMSSMT_SHA2_60_6_256   MTType = 0x07
+	XMSSMT_SHA2_60_12_256  MTType = 0x08
+	XMSSMT_SHA2_20_2_512   MTType = 0x09
+	XMSSMT_SHA2_20_4_512   MTType = 0x0a
+	XMSSMT_SHA2_40_2_512   MTType = 0x0b
+	XMSSMT_SHA2_40_4_512   MTType = 0x0c
+	XMSSMT_SHA2_40_8_512   MTType = 0x0d
+	XMSSMT_SHA2_60_3_512   MTType = 0x0e
+	XMSSMT_SHA2_60_6_512   MTType = 0x0f
+	XMSSMT_SHA2_60_12_512  MTType = 0x10
+	XMSSMT_SHAKE_20_2_256  MTType = 0x11
+	XMSSMT_SHAKE_20_4_256  MTType = 0x12
+	XMSSMT_SHAKE_40_2_256  MTType = 0x13
+	XMSSMT_SHAKE_40_4_256  MTType = 0x14
+	XMSSMT_SHAKE_40_8_256  MTType = 0x15
+	XMSSMT_SHAKE_60_3_256  MTType = 0x16
+	XMSSMT_SHAKE_60_6_256  MTType = 0x17
+	XMSSMT_SHAKE_60_12_256 MTType = 0x18
+	XMSSMT_SHAKE_20_2_512  MTType = 0x19
+	XMSSMT_SHAKE_20_4_512  MTType = 0x1a
+	XMSSMT_SHAKE_40_2_512  MTType = 0x1b
+	XMSSMT_SHAKE_40_4_512  MTType = 0x1c
+	XMSSMT_SHAKE_40_8_512  MTType = 0x1d
+	XMSSMT_SHAKE_60_3_512  MTType = 0x1e
+	XMSSMT_SHAKE_60_6_512  MTType = 0x1f
+	XMSSMT_SHAKE_60_12_512 MTType = 0x20
+)
+
+// w is the Winternitz parameter of every parameter set of RFC 8391
+const w = 16
+
+// len2 is the number of checksum digits, 3 for n = 32 and n = 64 with w = 16
+const len2 = 3
+
+// params is a XMSS or XMSS^MT parameter set, XMSS is XMSS^MT with d = 1
+type params struct {
+	oid  uint32
+	mt   bool
+	n    int
+	h, d int
+	// newHash returns the hash of the F, H, H_msg and PRF functions
+	newHash func() stdhash.Hash
+}
+
+// treeHeight is the height of a tree of one layer
+func (p *params) treeHeight() int {
+	return p.h / p.d
+}
+
+// len1 is the number of message digits
+func (p *params) len1() int {
+	return 2 * p.n
+}
+
+// wotsLen is the number of WOTS+ chains
+func (p *params) wotsLen() int {
+	return p.len1() + len2
+}
+
+// idxLen is the length of the index in a signature
+func (p *params) idxLen() int {
+	if !p.mt {
+		return 4
+	}
+	return (p.h + 7) / 8
+}
+
+// sigLen is the length of a signature
+func (p *params) sigLen() int {
+	return p.idxLen() + p.n + p.d*(p.wotsLen()+p.treeHeight())*p.n
+}
+
+func sha256New() stdhash.Hash { return hash.NewHasher(hash.SHA2_256) }
+
+func sha512New() stdhash.Hash { return hash.NewHasher(hash.SHA2_512) }
+
+// shake128New is SHAKE128 with 256 bits of output
+func shake128New() stdhash.Hash { return sha3Hash.NewShake128() }
+
+// shake256New is SHAKE256 with 512 bits of output
+func shake256New() stdhash.Hash { return sha3Hash.NewShake256() }
+
+// family returns the hash of a parameter set of n bytes
+func family(shake bool, n int) func() stdhash.Hash {
+	switch {
+	case !shake && n == 32:
+		return sha256New
+	case !shake && n == 64:
+		return sha512New
+	case n == 32:
+		return shake128New
+	default:
+		return shake256New
+	}
+}
+
+var (
+	xmssParamSets   = make(map[Type]*params)
+	xmssMTParamSets = make(map[MTType]*params)
+)
+
+func init() {
+	oid := uint32(1)
+	for _, shake := range []bool{false, true} {
+		for _, n := range []int{32, 64} {
+			for _, h := range []int{10, 16, 20} {
+				xmssParamSets[Type(oid)] = &params{oid: oid, n: n, h: h, d: 1, newHash: family(shake, n)}
+				oid++
+			}
+		}
+	}
+	oid = 1
+	for _, shake := range []bool{false, true} {
+		for _, n := range []int{32, 64} {
+			for _, hd := range [][2]int{{20, 2}, {20, 4}, {40, 2}, {40, 4}, {40, 8}, {60, 3}, {60, 6}, {60, 12}} {
+				xmssMTParamSets[MTType(oid)] = &params{oid: oid, mt: true, n: n, h: hd[0], d: hd[1], newHash: family(shake, n)}
+				oid++
+			}
+		}
+	}
+}
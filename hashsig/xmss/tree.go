@@ -0,0 +1,180 @@
+package xmss
+
+// baseW returns the base-16 digits of msg followed by the ones of its checksum,
+// RFC 8391 algorithm 1 and section 3.1.5.
+func baseW(p *params, msg []byte) []int {
+	digits := make([]int, 0, p.wotsLen())
+	for _, b := range msg {
+		digits = append(digits, int(b>>4), int(b&0xf))
+	}
+	csum := 0
+	for _, d := range digits {
+		csum += w - 1 - d
+	}
+	// len2 * lg(w) = 12 bits, shifted to fill two bytes
+	csum <<= 4
+	return append(digits, csum>>12&0xf, csum>>8&0xf, csum>>4&0xf)
+}
+
+// chain applies steps [start, start+steps) of WOTS+ chain to x in place, RFC 8391 algorithm 2
+func (h *hasher) chain(x []byte, start, steps int, pubSeed []byte, a *address) {
+	for i := start; i < start+steps; i++ {
+		a.setHash(uint32(i))
+		h.f(x, pubSeed, a)
+	}
+}
+
+// wotsSK returns the secret key of chain i of the WOTS+ key at a, a is an OTS address
+func (h *hasher) wotsSK(skSeed, pubSeed []byte, a *address, i int) []byte {
+	a.setChain(uint32(i))
+	a.setHash(0)
+	a.setKeyAndMask(0)
+	out := make([]byte, h.n)
+	h.prfKeygen(out, skSeed, pubSeed, a)
+	return out
+}
+
+// wotsPK returns the WOTS+ public key at a, RFC 8391 algorithm 4
+func (h *hasher) wotsPK(p *params, skSeed, pubSeed []byte, a *address) []byte {
+	pk := make([]byte, 0, p.wotsLen()*p.n)
+	for i := 0; i < p.wotsLen(); i++ {
+		x := h.wotsSK(skSeed, pubSeed, a, i)
+		h.chain(x, 0, w-1, pubSeed, a)
+		pk = append(pk, x...)
+	}
+	return pk
+}
+
+// wotsSign signs the n bytes msg with the WOTS+ key at a, RFC 8391 algorithm 5
+func (h *hasher) wotsSign(p *params, msg, skSeed, pubSeed []byte, a *address) []byte {
+	sig := make([]byte, 0, p.wotsLen()*p.n)
+	for i, d := range baseW(p, msg) {
+		x := h.wotsSK(skSeed, pubSeed, a, i)
+		h.chain(x, 0, d, pubSeed, a)
+		sig = append(sig, x...)
+	}
+	return sig
+}
+
+// wotsPKFromSig computes the WOTS+ public key of sig, RFC 8391 algorithm 6
+func (h *hasher) wotsPKFromSig(p *params, sig, msg, pubSeed []byte, a *address) []byte {
+	pk := append([]byte(nil), sig...)
+	for i, d := range baseW(p, msg) {
+		a.setChain(uint32(i))
+		h.chain(pk[i*p.n:(i+1)*p.n], d, w-1-d, pubSeed, a)
+	}
+	return pk
+}
+
+// lTree compresses a WOTS+ public key in place into its first n bytes, RFC 8391 algorithm 8
+func (h *hasher) lTree(pk, pubSeed []byte, a *address) []byte {
+	n := h.n
+	l := len(pk) / n
+	a.setTreeHeight(0)
+	for height := uint32(0); l > 1; height++ {
+		a.setTreeHeight(height)
+		for i := 0; i < l/2; i++ {
+			a.setTreeIndex(uint32(i))
+			h.randHash(pk[i*n:(i+1)*n], pk[2*i*n:(2*i+1)*n], pk[(2*i+1)*n:(2*i+2)*n], pubSeed, a)
+		}
+		if l%2 == 1 {
+			copy(pk[(l/2)*n:], pk[(l-1)*n:l*n])
+		}
+		l = (l + 1) / 2
+	}
+	return pk[:n]
+}
+
+// leaf returns the leaf i of the tree at layer and tree
+func (h *hasher) leaf(p *params, skSeed, pubSeed []byte, layer uint32, tree uint64, i uint32) []byte {
+	var a address
+	a.setLayer(layer)
+	a.setTree(tree)
+	a.setType(addrOTS)
+	a.setOTS(i)
+	pk := h.wotsPK(p, skSeed, pubSeed, &a)
+	a.setType(addrLTree)
+	a.setOTS(i)
+	return h.lTree(pk, pubSeed, &a)
+}
+
+// merkleTree is one XMSS tree of a layer with all its nodes
+type merkleTree struct {
+	layer uint32
+	tree  uint64
+	// nodes[k] holds the nodes of height k from left to right
+	nodes [][]byte
+}
+
+// newMerkleTree computes every node of the tree at layer and tree, that is 2^h' leaves
+func newMerkleTree(h *hasher, p *params, skSeed, pubSeed []byte, layer uint32, tree uint64) *merkleTree {
+	height := p.treeHeight()
+	t := &merkleTree{layer: layer, tree: tree, nodes: make([][]byte, height+1)}
+	leaves := 1 << uint(height)
+	t.nodes[0] = make([]byte, 0, leaves*p.n)
+	for i := 0; i < leaves; i++ {
+		t.nodes[0] = append(t.nodes[0], h.leaf(p, skSeed, pubSeed, layer, tree, uint32(i))...)
+	}
+	var a address
+	a.setLayer(layer)
+	a.setTree(tree)
+	a.setType(addrTree)
+	for k := 0; k < height; k++ {
+		a.setTreeHeight(uint32(k))
+		below := t.nodes[k]
+		t.nodes[k+1] = make([]byte, len(below)/2)
+		for j := 0; j < len(below)/p.n/2; j++ {
+			a.setTreeIndex(uint32(j))
+			h.randHash(t.nodes[k+1][j*p.n:(j+1)*p.n], below[2*j*p.n:(2*j+1)*p.n], below[(2*j+1)*p.n:(2*j+2)*p.n], pubSeed, &a)
+		}
+	}
+	return t
+}
+
+// root returns the root of t
+func (t *merkleTree) root() []byte {
+	return t.nodes[len(t.nodes)-1]
+}
+
+// sign returns the WOTS+ signature of msg with the leaf i followed by its authentication path,
+// RFC 8391 algorithm 11
+func (t *merkleTree) sign(h *hasher, p *params, msg, skSeed, pubSeed []byte, i uint32) []byte {
+	var a address
+	a.setLayer(t.layer)
+	a.setTree(t.tree)
+	a.setType(addrOTS)
+	a.setOTS(i)
+	sig := h.wotsSign(p, msg, skSeed, pubSeed, &a)
+	for k := 0; k < p.treeHeight(); k++ {
+		j := int(i>>uint(k)) ^ 1
+		sig = append(sig, t.nodes[k][j*p.n:(j+1)*p.n]...)
+	}
+	return sig
+}
+
+// rootFromSig computes the root of the tree at layer and tree from the signature of msg by
+// the leaf i, sig is the WOTS+ signature followed by the authentication path, RFC 8391 algorithm 13
+func (h *hasher) rootFromSig(p *params, layer uint32, tree uint64, i uint32, sig, msg, pubSeed []byte) []byte {
+	n := p.n
+	var a address
+	a.setLayer(layer)
+	a.setTree(tree)
+	a.setType(addrOTS)
+	a.setOTS(i)
+	wotsSig, auth := sig[:p.wotsLen()*n], sig[p.wotsLen()*n:]
+	pk := h.wotsPKFromSig(p, wotsSig, msg, pubSeed, &a)
+	a.setType(addrLTree)
+	a.setOTS(i)
+	node := append([]byte(nil), h.lTree(pk, pubSeed, &a)...)
+	a.setType(addrTree)
+	for k := 0; k < p.treeHeight(); k++ {
+		a.setTreeHeight(uint32(k))
+		a.setTreeIndex(i >> uint(k+1))
+		if (i>>uint(k))&1 == 0 {
+			h.randHash(node, node, auth[k*n:(k+1)*n], pubSeed, &a)
+		} else {
+			h.randHash(node, auth[k*n:(k+1)*n], node, pubSeed, &a)
+		}
+	}
+	return node
+}
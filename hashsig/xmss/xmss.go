@@ -0,0 +1,272 @@
+//Package xmss implements the eXtended Merkle Signature Scheme of RFC 8391, XMSS and its
+// multi-tree variant XMSS^MT, with the SHA2 and SHAKE parameter sets. WOTS+ secret keys
+// are derived with PRF_keygen as in NIST SP 800-208. Signing is stateful, see the hashsig package.
+package xmss
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/meshplus/crypto-standard/hashsig"
+)
+
+//error defines
+var (
+	ErrUnsupported       = errors.New("xmss: unsupported parameter set")
+	ErrInvalidPublicKey  = errors.New("xmss: invalid public key")
+	ErrInvalidPrivateKey = errors.New("xmss: invalid private key")
+	ErrInvalidSignature  = errors.New("xmss: invalid signature")
+)
+
+//PublicKey a XMSS or XMSS^MT public key
+type PublicKey struct {
+	p       *params
+	root    []byte
+	pubSeed []byte
+}
+
+//PrivateKey a XMSS or XMSS^MT private key. The index of the next one-time key is not part
+// of the private key, it is kept by the hashsig.Store given to NewSigner.
+type PrivateKey struct {
+	PublicKey
+	skSeed []byte
+	skPRF  []byte
+}
+
+//GenerateKey generate a XMSS key. It computes the whole tree, 2^h WOTS+ keys, which takes
+// seconds for a height of 10 and hours for a height of 20, prefer XMSS^MT for large key spaces.
+func GenerateKey(rand io.Reader, typ Type) (*PrivateKey, error) {
+	p, ok := xmssParamSets[typ]
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return generateKey(rand, p)
+}
+
+//GenerateMTKey generate a XMSS^MT key, it computes the top tree of height h/d
+func GenerateMTKey(rand io.Reader, typ MTType) (*PrivateKey, error) {
+	p, ok := xmssMTParamSets[typ]
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return generateKey(rand, p)
+}
+
+func generateKey(rand io.Reader, p *params) (*PrivateKey, error) {
+	buf := make([]byte, 3*p.n)
+	if _, err := io.ReadFull(rand, buf); err != nil {
+		return nil, err
+	}
+	key := &PrivateKey{
+		PublicKey: PublicKey{p: p, pubSeed: buf[2*p.n:]},
+		skSeed:    buf[:p.n],
+		skPRF:     buf[p.n : 2*p.n],
+	}
+	key.root = key.topTree(newHasher(p)).root()
+	return key, nil
+}
+
+// topTree computes the only tree of the top layer
+func (k *PrivateKey) topTree(h *hasher) *merkleTree {
+	return newMerkleTree(h, k.p, k.skSeed, k.pubSeed, uint32(k.p.d-1), 0)
+}
+
+//Public return the public key
+func (k *PrivateKey) Public() *PublicKey {
+	pub := k.PublicKey
+	return &pub
+}
+
+//Signatures return the number of messages the key can sign over its lifetime
+func (k *PrivateKey) Signatures() uint64 {
+	if k.p.h == 64 {
+		return ^uint64(0)
+	}
+	return 1 << uint(k.p.h)
+}
+
+//MarshalBinary encode the private key as u8(XMSS^MT) || OID || SK_SEED || SK_PRF || PUB_SEED || root
+func (k *PrivateKey) MarshalBinary() ([]byte, error) {
+	ret := make([]byte, 5, 5+4*k.p.n)
+	if k.p.mt {
+		ret[0] = 1
+	}
+	binary.BigEndian.PutUint32(ret[1:], k.p.oid)
+	ret = append(ret, k.skSeed...)
+	ret = append(ret, k.skPRF...)
+	ret = append(ret, k.pubSeed...)
+	return append(ret, k.root...), nil
+}
+
+//ParsePrivateKey parse the output of PrivateKey.MarshalBinary
+func ParsePrivateKey(data []byte) (*PrivateKey, error) {
+	if len(data) < 5 || data[0] > 1 {
+		return nil, ErrInvalidPrivateKey
+	}
+	p := lookup(data[0] == 1, binary.BigEndian.Uint32(data[1:]))
+	if p == nil {
+		return nil, ErrUnsupported
+	}
+	if len(data) != 5+4*p.n {
+		return nil, ErrInvalidPrivateKey
+	}
+	data = append([]byte(nil), data[5:]...)
+	return &PrivateKey{
+		PublicKey: PublicKey{p: p, pubSeed: data[2*p.n : 3*p.n], root: data[3*p.n:]},
+		skSeed:    data[:p.n],
+		skPRF:     data[p.n : 2*p.n],
+	}, nil
+}
+
+// lookup returns the parameter set of oid
+func lookup(mt bool, oid uint32) *params {
+	if mt {
+		return xmssMTParamSets[MTType(oid)]
+	}
+	return xmssParamSets[Type(oid)]
+}
+
+//Bytes encode the public key as OID || root || SEED, RFC 8391 section 4.1.7
+func (pub *PublicKey) Bytes() []byte {
+	ret := make([]byte, 4, 4+2*pub.p.n)
+	binary.BigEndian.PutUint32(ret, pub.p.oid)
+	ret = append(ret, pub.root...)
+	return append(ret, pub.pubSeed...)
+}
+
+//ParsePublicKey parse a XMSS public key
+func ParsePublicKey(data []byte) (*PublicKey, error) {
+	return parsePublicKey(false, data)
+}
+
+//ParseMTPublicKey parse a XMSS^MT public key, the OIDs of XMSS and XMSS^MT overlap
+func ParseMTPublicKey(data []byte) (*PublicKey, error) {
+	return parsePublicKey(true, data)
+}
+
+func parsePublicKey(mt bool, data []byte) (*PublicKey, error) {
+	if len(data) < 4 {
+		return nil, ErrInvalidPublicKey
+	}
+	p := lookup(mt, binary.BigEndian.Uint32(data))
+	if p == nil {
+		return nil, ErrUnsupported
+	}
+	if len(data) != 4+2*p.n {
+		return nil, ErrInvalidPublicKey
+	}
+	data = append([]byte(nil), data[4:]...)
+	return &PublicKey{p: p, root: data[:p.n], pubSeed: data[p.n:]}, nil
+}
+
+//Verify verify a signature of msg, RFC 8391 algorithms 14 and 17
+func (pub *PublicKey) Verify(msg, sig []byte) error {
+	p := pub.p
+	if len(sig) != p.sigLen() {
+		return ErrInvalidSignature
+	}
+	var idx uint64
+	for _, b := range sig[:p.idxLen()] {
+		idx = idx<<8 | uint64(b)
+	}
+	if p.h < 64 && idx>>uint(p.h) != 0 {
+		return ErrInvalidSignature
+	}
+	h := newHasher(p)
+	sig = sig[p.idxLen():]
+	node := h.hashMsg(sig[:p.n], pub.root, idx, msg)
+	sig = sig[p.n:]
+
+	height := uint(p.treeHeight())
+	layerLen := (p.wotsLen() + p.treeHeight()) * p.n
+	for j := 0; j < p.d; j++ {
+		leaf := uint32(idx & (1<<height - 1))
+		idx >>= height
+		node = h.rootFromSig(p, uint32(j), idx, leaf, sig[j*layerLen:(j+1)*layerLen], node, pub.pubSeed)
+	}
+	if subtle.ConstantTimeCompare(node, pub.root) != 1 {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+//Signer sign messages with a XMSS or XMSS^MT private key. It keeps one tree per layer in memory,
+// 2^(h/d+1) * n bytes each. Signer is safe for concurrent use, but a private key must never be used
+// by two Signers at the same time.
+type Signer struct {
+	lock    sync.Mutex
+	key     *PrivateKey
+	counter *hashsig.Counter
+	h       *hasher
+	// trees[j] is the current tree of layer j, sigs[j] the signature of its root by layer j + 1
+	trees []*merkleTree
+	sigs  [][]byte
+}
+
+//NewSigner return a Signer that persists the used one-time keys to store before using them,
+// batch is the number of indices reserved at once as in hashsig.NewCounter.
+// It recomputes the top tree and returns ErrInvalidPrivateKey if its root does not match.
+func NewSigner(key *PrivateKey, store hashsig.Store, batch uint64) (*Signer, error) {
+	counter, err := hashsig.NewCounter(store, key.Signatures(), batch)
+	if err != nil {
+		return nil, err
+	}
+	s := &Signer{
+		key:     key,
+		counter: counter,
+		h:       newHasher(key.p),
+		trees:   make([]*merkleTree, key.p.d),
+		sigs:    make([][]byte, key.p.d),
+	}
+	top := key.topTree(s.h)
+	if subtle.ConstantTimeCompare(top.root(), key.root) != 1 {
+		return nil, ErrInvalidPrivateKey
+	}
+	s.trees[key.p.d-1] = top
+	return s, nil
+}
+
+//Remaining return the number of messages that can still be signed
+func (s *Signer) Remaining() uint64 {
+	return s.counter.Remaining()
+}
+
+//Sign sign msg with the next one-time key, the index is persisted before the signature is computed.
+// It returns hashsig.ErrKeyExhausted once every one-time key is used.
+func (s *Signer) Sign(msg []byte) ([]byte, error) {
+	idx, err := s.counter.Next()
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	k, p := s.key, s.key.p
+	height := uint(p.treeHeight())
+	// the trees of the lower layers change when idx enters a new tree
+	for j := p.d - 2; j >= 0; j-- {
+		tree := idx >> (height * uint(j+1))
+		if s.trees[j] != nil && s.trees[j].tree == tree {
+			continue
+		}
+		s.trees[j] = newMerkleTree(s.h, p, k.skSeed, k.pubSeed, uint32(j), tree)
+		leaf := uint32(tree & (1<<height - 1))
+		s.sigs[j] = s.trees[j+1].sign(s.h, p, s.trees[j].root(), k.skSeed, k.pubSeed, leaf)
+	}
+
+	sig := make([]byte, p.idxLen(), p.sigLen())
+	for i := range sig {
+		sig[i] = byte(idx >> (8 * uint(len(sig)-1-i)))
+	}
+	r := s.h.randomizer(k.skPRF, idx)
+	sig = append(sig, r...)
+	digest := s.h.hashMsg(r, k.root, idx, msg)
+	sig = append(sig, s.trees[0].sign(s.h, p, digest, k.skSeed, k.pubSeed, uint32(idx&(1<<height-1)))...)
+	for j := 0; j < p.d-1; j++ {
+		sig = append(sig, s.sigs[j]...)
+	}
+	return sig, nil
+}
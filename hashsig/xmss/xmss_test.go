@@ -0,0 +1,161 @@
+package xmss
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+	"github.com/meshplus/crypto-standard/hashsig"
+	"github.com/stretchr/testify/assert"
+)
+
+func signAndVerify(t *testing.T, key *PrivateKey, pub *PublicKey, count int) {
+	signer, err := NewSigner(key, new(hashsig.MemoryStore), 0)
+	assert.Nil(t, err)
+	for i := 0; i < count; i++ {
+		msg := []byte{byte(i)}
+		sig, err := signer.Sign(msg)
+		assert.Nil(t, err)
+		assert.Equal(t, key.p.sigLen(), len(sig))
+		assert.Nil(t, pub.Verify(msg, sig))
+		assert.Equal(t, ErrInvalidSignature, pub.Verify([]byte{byte(i + 1)}, sig))
+		sig[len(sig)-1] ^= 1
+		assert.Equal(t, ErrInvalidSignature, pub.Verify(msg, sig))
+		sig[len(sig)-1] ^= 1
+		assert.Equal(t, ErrInvalidSignature, pub.Verify(msg, sig[:len(sig)-1]))
+	}
+	assert.Equal(t, key.Signatures()-uint64(count), signer.Remaining())
+}
+
+func TestXMSS(t *testing.T) {
+	key, err := GenerateKey(rand.Reader, XMSS_SHA2_10_256)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1024), key.Signatures())
+	assert.Equal(t, 2500, key.p.sigLen())
+	pub, err := ParsePublicKey(key.Public().Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, 68, len(pub.Bytes()))
+
+	data, err := key.MarshalBinary()
+	assert.Nil(t, err)
+	parsed, err := ParsePrivateKey(data)
+	assert.Nil(t, err)
+	assert.Equal(t, key, parsed)
+	signAndVerify(t, parsed, pub, 4)
+
+	// the OID of a XMSS^MT public key names another parameter set
+	mt, err := ParseMTPublicKey(pub.Bytes())
+	assert.Nil(t, err)
+	assert.NotEqual(t, pub.p, mt.p)
+
+	_, err = GenerateKey(rand.Reader, Type(0))
+	assert.Equal(t, ErrUnsupported, err)
+	data[len(data)-1] ^= 1
+	parsed, _ = ParsePrivateKey(data)
+	_, err = NewSigner(parsed, new(hashsig.MemoryStore), 0)
+	assert.Equal(t, ErrInvalidPrivateKey, err)
+}
+
+func TestXMSSMT(t *testing.T) {
+	for _, typ := range []MTType{XMSSMT_SHA2_20_4_256, XMSSMT_SHAKE_20_4_256, XMSSMT_SHAKE_20_4_512} {
+		key, err := GenerateMTKey(rand.Reader, typ)
+		assert.Nil(t, err)
+		pub, err := ParseMTPublicKey(key.Public().Bytes())
+		assert.Nil(t, err)
+		data, err := key.MarshalBinary()
+		assert.Nil(t, err)
+		parsed, err := ParsePrivateKey(data)
+		assert.Nil(t, err)
+		assert.Equal(t, key, parsed)
+		// 40 signatures cross the boundary of the first bottom tree
+		signAndVerify(t, parsed, pub, 40)
+	}
+	assert.Equal(t, 4963, xmssMTParamSets[XMSSMT_SHA2_20_2_256].sigLen())
+	assert.Equal(t, 9251, xmssMTParamSets[XMSSMT_SHA2_20_4_256].sigLen())
+	assert.Equal(t, 104520, xmssMTParamSets[XMSSMT_SHA2_60_12_512].sigLen())
+}
+
+// refHash is the first 10 bytes of SHAKE128, the digest printed by test/vectors of the reference implementation
+func refHash(b []byte) string {
+	h := sha3Hash.NewShake128()
+	_, _ = h.Write(b)
+	out := make([]byte, 10)
+	_, _ = h.Read(out)
+	return hex.EncodeToString(out)
+}
+
+func TestReferenceVectors(t *testing.T) {
+	// test/vectors of the RFC 8391 reference implementation: the key of the seed 0, 1, ..., 3n-1
+	// and its signature of the message {37} with the index 2^(h-1)
+	cases := []struct {
+		p       *params
+		pk, sig string
+	}{
+		{xmssParamSets[XMSS_SHA2_10_256], "7de72d192121f414d4bb", "8b6cb278d50a3694ca38"},
+		{xmssParamSets[XMSS_SHA2_10_512], "74ee7c42b4e42a424ed9", "b9e63b0376a550eabe1b"},
+		{xmssParamSets[XMSS_SHAKE_10_256], "764614ee2ce5e4bf0114", "3e9035cffa0fd4be98bd"},
+		{xmssParamSets[XMSS_SHAKE_10_512], "e47fe831b6ee463e2881", "ce2dc09cd7ad8c87ae06"},
+		{xmssMTParamSets[XMSSMT_SHA2_20_4_256], "9df4c75282451bf2bc53", "fd4ff4c18801147b2804"},
+		{xmssMTParamSets[XMSSMT_SHA2_20_4_512], "fdeb0cc4fed643bf70ce", "fbeb33a7aed7af7ea526"},
+		{xmssMTParamSets[XMSSMT_SHAKE_20_4_256], "dbe6fc388fbd610b3401", "2c2a66cae9a16414088d"},
+		{xmssMTParamSets[XMSSMT_SHAKE_20_4_512], "3739e7d3668932d9ca44", "ec8d62bb9d4ba74c6729"},
+	}
+	for _, c := range cases {
+		if testing.Short() && c.p.n == 64 {
+			continue
+		}
+		seed := make([]byte, 3*c.p.n)
+		for i := range seed {
+			seed[i] = byte(i)
+		}
+		key, err := generateKey(bytes.NewReader(seed), c.p)
+		assert.Nil(t, err)
+		// without the OID
+		assert.Equal(t, c.pk, refHash(key.Public().Bytes()[4:]), "oid %d", c.p.oid)
+
+		store := new(hashsig.MemoryStore)
+		_ = store.Save(1 << uint(c.p.h-1))
+		signer, err := NewSigner(key, store, 0)
+		assert.Nil(t, err)
+		sig, err := signer.Sign([]byte{37})
+		assert.Nil(t, err)
+		assert.Equal(t, c.sig, refHash(sig), "oid %d", c.p.oid)
+		assert.Nil(t, key.Public().Verify([]byte{37}, sig))
+	}
+}
+
+func TestSignerState(t *testing.T) {
+	// a toy parameter set of 16 one-time keys
+	p := &params{oid: 0xff, mt: true, n: 32, h: 4, d: 2, newHash: sha256New}
+	key, err := generateKey(rand.Reader, p)
+	assert.Nil(t, err)
+	store := hashsig.NewFileStore(filepath.Join(t.TempDir(), "xmss.state"))
+	signer, err := NewSigner(key, store, 4)
+	assert.Nil(t, err)
+	// the index is the first byte of a signature
+	for i := 0; i < 3; i++ {
+		sig, err := signer.Sign([]byte("msg"))
+		assert.Nil(t, err)
+		assert.Equal(t, byte(i), sig[0])
+		assert.Nil(t, key.Public().Verify([]byte("msg"), sig))
+	}
+	next, err := store.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), next)
+
+	// a restart skips the reserved indices
+	signer, err = NewSigner(key, store, 4)
+	assert.Nil(t, err)
+	for i := 4; i < 16; i++ {
+		sig, err := signer.Sign([]byte("msg"))
+		assert.Nil(t, err)
+		assert.Equal(t, byte(i), sig[0])
+		assert.Nil(t, key.Public().Verify([]byte("msg"), sig))
+	}
+	_, err = signer.Sign([]byte("msg"))
+	assert.Equal(t, hashsig.ErrKeyExhausted, err)
+	assert.Equal(t, uint64(0), signer.Remaining())
+}
@@ -0,0 +1,279 @@
+//Package keystore encrypts and decrypts secp256k1 keys in the Ethereum JSON keystore V3 format
+// (Web3 Secret Storage) of geth and the wallets: scrypt or PBKDF2-HMAC-SHA256 derives a 32 bytes key,
+// its first half encrypts the private key with AES-128-CTR and its second half is hashed with the
+// ciphertext by Keccak-256 into the MAC.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"strings"
+
+	"github.com/meshplus/crypto-standard/asym"
+	"github.com/meshplus/crypto-standard/asym/secp256k1"
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/meshplus/crypto-standard/password"
+)
+
+const (
+	version     = 3
+	cipherName  = "aes-128-ctr"
+	kdfScrypt   = "scrypt"
+	kdfPBKDF2   = "pbkdf2"
+	pbkdf2PRF   = "hmac-sha256"
+	dkLen       = 32
+	saltLen     = 32
+	maxScryptNR = 1 << 23 // 128*N*r at most 1 GiB
+	maxScryptRP = 1 << 30 // r*p < 2^30 of RFC 7914
+	maxPBKDF2C  = 10000000
+)
+
+//error defines
+var (
+	ErrDecrypt         = errors.New("keystore: could not decrypt key with given password")
+	ErrVersion         = errors.New("keystore: version is not 3")
+	ErrAddressMismatch = errors.New("keystore: address does not match the private key")
+	ErrNotK1Key        = errors.New("keystore: key is not a secp256k1 key")
+)
+
+//Parameters of geth: StandardParams are the default of Encrypt, LightParams use 4 MB and about 100 ms
+var (
+	StandardParams password.Params = password.ScryptParams{LogN: 18, R: 8, P: 1}
+	LightParams    password.Params = password.ScryptParams{LogN: 12, R: 8, P: 6}
+)
+
+type keyJSON struct {
+	Address string     `json:"address,omitempty"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams cipherParamsJSON `json:"cipherparams"`
+	KDF          string           `json:"kdf"`
+	KDFParams    kdfParamsJSON    `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// kdfParamsJSON holds the parameters of both KDFs, n, r and p of scrypt, c and prf of pbkdf2
+type kdfParamsJSON struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+	Salt  string `json:"salt"`
+}
+
+//Encrypt return the V3 keystore JSON of a AlgoP256K1 or AlgoP256K1Recover key.
+// params is a password.ScryptParams or a password.PBKDF2Params with SHA2_256, nil means StandardParams.
+// The salt is 32 bytes unless SaltLen is set, the KeyLen is ignored.
+// The address is the one of ECDSAPublicKey.Bytes in AlgoP256K1Recover mode.
+func Encrypt(priv *asym.ECDSAPrivateKey, pw []byte, params password.Params) ([]byte, error) {
+	addr, err := address(priv)
+	if err != nil {
+		return nil, err
+	}
+	d, err := priv.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if params == nil {
+		params = StandardParams
+	}
+	var kdfName string
+	var kdf kdfParamsJSON
+	var salt []byte
+	switch p := params.(type) {
+	case password.ScryptParams:
+		if p.SaltLen == 0 {
+			p.SaltLen = saltLen
+		}
+		p = password.WithDefaults(p).(password.ScryptParams)
+		if p.LogN >= 63 {
+			return nil, errors.New("keystore: scrypt N is too large")
+		}
+		params, salt = p, make([]byte, p.SaltLen)
+		kdfName, kdf = kdfScrypt, kdfParamsJSON{N: 1 << p.LogN, R: p.R, P: p.P}
+	case password.PBKDF2Params:
+		if p.SaltLen == 0 {
+			p.SaltLen = saltLen
+		}
+		p = password.WithDefaults(p).(password.PBKDF2Params)
+		if p.HashType != hash.SHA2_256 {
+			return nil, errors.New("keystore: PBKDF2 of V3 supports only " + pbkdf2PRF)
+		}
+		params, salt = p, make([]byte, p.SaltLen)
+		kdfName, kdf = kdfPBKDF2, kdfParamsJSON{C: p.Iterations, PRF: pbkdf2PRF}
+	default:
+		return nil, errors.New("keystore: KDF must be scrypt or PBKDF2")
+	}
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16)
+	for _, b := range [][]byte{salt, iv, id} {
+		if _, err = io.ReadFull(rand.Reader, b); err != nil {
+			return nil, err
+		}
+	}
+	kdf.DKLen, kdf.Salt = dkLen, hex.EncodeToString(salt)
+	// Decrypt would refuse the cost
+	if _, err = parseKDF(&cryptoJSON{KDF: kdfName, KDFParams: kdf}); err != nil {
+		return nil, err
+	}
+	derived, err := password.DeriveKey(pw, salt, dkLen, params)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := aesCTR(derived[:16], iv, d)
+
+	// random UUID, version 4 and variant RFC 4122
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return json.Marshal(keyJSON{
+		Address: hex.EncodeToString(addr),
+		Crypto: cryptoJSON{
+			Cipher:       cipherName,
+			CipherText:   hex.EncodeToString(ciphertext),
+			CipherParams: cipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          kdfName,
+			KDFParams:    kdf,
+			MAC:          hex.EncodeToString(mac(derived, ciphertext)),
+		},
+		ID:      fmt.Sprintf("%x-%x-%x-%x-%x", id[:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Version: version,
+	})
+}
+
+//Decrypt return the private key of a V3 keystore JSON in AlgoP256K1Recover mode.
+// A wrong password returns ErrDecrypt, an address field which is not the one of the key returns ErrAddressMismatch.
+func Decrypt(data, pw []byte) (*asym.ECDSAPrivateKey, error) {
+	var k keyJSON
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, err
+	}
+	if k.Version != version {
+		return nil, ErrVersion
+	}
+	if k.Crypto.Cipher != cipherName {
+		return nil, errors.New("keystore: unsupported cipher " + k.Crypto.Cipher)
+	}
+	params, err := parseKDF(&k.Crypto)
+	if err != nil {
+		return nil, err
+	}
+	salt, err := hex.DecodeString(k.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, errors.New("keystore: invalid salt")
+	}
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("keystore: invalid iv")
+	}
+	ciphertext, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, errors.New("keystore: invalid ciphertext")
+	}
+	expected, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, errors.New("keystore: invalid mac")
+	}
+
+	derived, err := password.DeriveKey(pw, salt, k.Crypto.KDFParams.DKLen, params)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(mac(derived, ciphertext), expected) != 1 {
+		return nil, ErrDecrypt
+	}
+	d := aesCTR(derived[:16], iv, ciphertext)
+	if len(d) == 0 || len(d) > 32 {
+		return nil, errors.New("keystore: invalid private key length")
+	}
+	priv := new(asym.ECDSAPrivateKey)
+	if err = priv.FromBytes(d, asym.AlgoP256K1Recover); err != nil {
+		return nil, err
+	}
+	if priv.D.Sign() == 0 || priv.D.Cmp(secp256k1.S256().Params().N) >= 0 {
+		return nil, errors.New("keystore: invalid private key")
+	}
+	if k.Address != "" {
+		got, _ := address(priv)
+		want, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(k.Address), "0x"))
+		if err != nil || subtle.ConstantTimeCompare(got, want) != 1 {
+			return nil, ErrAddressMismatch
+		}
+	}
+	return priv, nil
+}
+
+// parseKDF returns the KDF parameters of the crypto section. The memory of scrypt is at
+// most 1 GiB, r*p is below 2^30 as RFC 7914 requires and PBKDF2 runs at most maxPBKDF2C iterations.
+func parseKDF(c *cryptoJSON) (password.Params, error) {
+	p := &c.KDFParams
+	if p.DKLen < dkLen || p.DKLen > 64 {
+		return nil, errors.New("keystore: dklen is not between 32 and 64")
+	}
+	switch c.KDF {
+	case kdfScrypt:
+		if p.N <= 1 || p.N&(p.N-1) != 0 || p.R <= 0 || p.P <= 0 || p.R > maxScryptNR/p.N ||
+			uint64(p.R)*uint64(p.P) >= maxScryptRP {
+			return nil, errors.New("keystore: invalid scrypt parameters")
+		}
+		return password.ScryptParams{LogN: uint8(bits.TrailingZeros(uint(p.N))), R: p.R, P: p.P}, nil
+	case kdfPBKDF2:
+		if p.PRF != pbkdf2PRF {
+			return nil, errors.New("keystore: unsupported PBKDF2 prf " + p.PRF)
+		}
+		if p.C <= 0 || p.C > maxPBKDF2C {
+			return nil, errors.New("keystore: invalid PBKDF2 iteration count")
+		}
+		return password.PBKDF2Params{HashType: hash.SHA2_256, Iterations: p.C}, nil
+	}
+	return nil, errors.New("keystore: unsupported kdf " + c.KDF)
+}
+
+// address returns the 20 bytes address of a secp256k1 key
+func address(priv *asym.ECDSAPrivateKey) ([]byte, error) {
+	if priv.Curve != secp256k1.S256() {
+		return nil, ErrNotK1Key
+	}
+	d, err := priv.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	recoverKey := new(asym.ECDSAPrivateKey)
+	if err = recoverKey.FromBytes(d, asym.AlgoP256K1Recover); err != nil {
+		return nil, err
+	}
+	return recoverKey.Public().(*asym.ECDSAPublicKey).Bytes()
+}
+
+// mac is keccak256 of the second half of the derived key || ciphertext
+func mac(derived, ciphertext []byte) []byte {
+	ret, _ := hash.NewHasher(hash.KECCAK_256).BatchHash([][]byte{derived[16:32], ciphertext})
+	return ret
+}
+
+func aesCTR(key, iv, in []byte) []byte {
+	block, _ := aes.NewCipher(key)
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out
+}
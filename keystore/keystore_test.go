@@ -0,0 +1,122 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/asym"
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/meshplus/crypto-standard/password"
+	"github.com/stretchr/testify/assert"
+)
+
+// the test vectors of go-ethereum accounts/keystore/testdata
+var gethVectors = []struct {
+	json, password, priv, address string
+}{
+	// Web3 Secret Storage definition, without address
+	{
+		`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+		"testpassword", "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d", "",
+	},
+	{
+		`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+		"testpassword", "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d", "",
+	},
+	// keys with leading zero bytes were written without them
+	{
+		`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"e0c41130a323adc1446fc82f724bca2f"},"ciphertext":"9517cd5bdbe69076f9bf5057248c6c050141e970efa36ce53692d5d59a3984","kdf":"scrypt","kdfparams":{"dklen":32,"n":2,"r":8,"p":1,"salt":"711f816911c92d649fb4c84b047915679933555030b3552c1212609b38208c63"},"mac":"d5e116151c6aa71470e67a7d42c9620c75c4d23229847dcc127794f0732b0db5"},"id":"fecfc4ce-e956-48fd-953b-30f8b52ed66c","version":3}`,
+		"foo", "00fa7b3db73dc7dfdf8c5fbdb796d741e4488628c41fc4febd9160a866ba0f35", "",
+	},
+	// files written by geth
+	{
+		`{"address":"f466859ead1932d743d622cb74fc058882e8648a","crypto":{"cipher":"aes-128-ctr","ciphertext":"cb664472deacb41a2e995fa7f96fe29ce744471deb8d146a0e43c7898c9ddd4d","cipherparams":{"iv":"dfd9ee70812add5f4b8f89d0811c9158"},"kdf":"scrypt","kdfparams":{"dklen":32,"n":8,"p":16,"r":8,"salt":"0d6769bf016d45c479213990d6a08d938469c4adad8a02ce507b4a4e7b7739f1"},"mac":"bac9af994b15a45dd39669fc66f9aa8a3b9dd8c22cb16e4d8d7ea089d0f1a1a9"},"id":"472e8b3d-afb6-45b5-8111-72c89895099a","version":3}`,
+		"foobar", "", "f466859ead1932d743d622cb74fc058882e8648a",
+	},
+	{
+		`{"address":"289d485d9771714cce91d3393d764e1311907acc","crypto":{"cipher":"aes-128-ctr","ciphertext":"faf32ca89d286b107f5e6d842802e05263c49b78d46eac74e6109e9a963378ab","cipherparams":{"iv":"558833eec4a665a8c55608d7d503407d"},"kdf":"scrypt","kdfparams":{"dklen":32,"n":8,"p":16,"r":8,"salt":"d571fff447ffb24314f9513f5160246f09997b857ac71348b73e785aab40dc04"},"mac":"21edb85ff7d0dab1767b9bf498f2c3cb7be7609490756bd32300bb213b59effe"},"id":"3279afcf-55ba-43ff-8997-02dcc46a6525","version":3}`,
+		"foobar", "", "289d485d9771714cce91d3393d764e1311907acc",
+	},
+	{
+		`{"address":"7ef5a6135f1fd6a02593eedc869c6d41d934aef8","crypto":{"cipher":"aes-128-ctr","ciphertext":"1d0839166e7a15b9c1333fc865d69858b22df26815ccf601b28219b6192974e1","cipherparams":{"iv":"8df6caa7ff1b00c4e871f002cb7921ed"},"kdf":"scrypt","kdfparams":{"dklen":32,"n":8,"p":16,"r":8,"salt":"e5e6ef3f4ea695f496b643ebd3f75c0aa58ef4070e90c80c5d3fb0241bf1595c"},"mac":"6d16dfde774845e4585357f24bce530528bc69f4f84e1e22880d34fa45c273e5"},"id":"950077c7-71e3-4c44-a4a1-143919141ed4","version":3}`,
+		"foobar", "", "7ef5a6135f1fd6a02593eedc869c6d41d934aef8",
+	},
+	{
+		`{"address":"45dea0fb0bba44f4fcf290bba71fd57d7117cbb8","crypto":{"cipher":"aes-128-ctr","ciphertext":"b87781948a1befd247bff51ef4063f716cf6c2d3481163e9a8f42e1f9bb74145","cipherparams":{"iv":"dc4926b48a105133d2f16b96833abf1e"},"kdf":"scrypt","kdfparams":{"dklen":32,"n":2,"p":1,"r":8,"salt":"004244bbdc51cadda545b1cfa43cff9ed2ae88e08c61f1479dbb45410722f8f0"},"mac":"39990c1684557447940d4c69e06b1b82b2aceacb43f284df65c956daf3046b85"},"id":"ce541d8d-c79b-40f8-9f8c-20f59616faba","version":3}`,
+		"", "", "45dea0fb0bba44f4fcf290bba71fd57d7117cbb8",
+	},
+}
+
+func TestDecryptGeth(t *testing.T) {
+	for i, v := range gethVectors {
+		priv, err := Decrypt([]byte(v.json), []byte(v.password))
+		assert.Nil(t, err, "vector %d", i)
+		assert.Equal(t, asym.AlgoP256K1Recover, priv.AlgorithmType())
+		d, _ := priv.Bytes()
+		if v.priv != "" {
+			assert.Equal(t, v.priv, hex.EncodeToString(d), "vector %d", i)
+		}
+		if v.address != "" {
+			address, _ := priv.Public().(*asym.ECDSAPublicKey).Bytes()
+			assert.Equal(t, v.address, hex.EncodeToString(address), "vector %d", i)
+		}
+
+		_, err = Decrypt([]byte(v.json), []byte(v.password+"bad"))
+		assert.Equal(t, ErrDecrypt, err)
+	}
+
+	// a file whose address is not the one of its key
+	swapped := strings.Replace(gethVectors[3].json, "f466859ead1932d743d622cb74fc058882e8648a", "289d485d9771714cce91d3393d764e1311907acc", 1)
+	_, err := Decrypt([]byte(swapped), []byte("foobar"))
+	assert.Equal(t, ErrAddressMismatch, err)
+	_, err = Decrypt([]byte(strings.Replace(gethVectors[3].json, `"version":3`, `"version":1`, 1)), []byte("foobar"))
+	assert.Equal(t, ErrVersion, err)
+	for _, bad := range []string{
+		strings.Replace(gethVectors[3].json, `"n":8`, `"n":7`, 1),
+		strings.Replace(gethVectors[3].json, `"p":16`, `"p":134217728`, 1),
+		strings.Replace(gethVectors[1].json, `"c":262144`, `"c":10000001`, 1),
+	} {
+		_, err = Decrypt([]byte(bad), []byte("foobar"))
+		assert.NotNil(t, err)
+	}
+}
+
+func TestEncrypt(t *testing.T) {
+	pw := []byte("password")
+	priv, _ := asym.GenerateKey(asym.AlgoP256K1Recover)
+	address, _ := priv.Public().(*asym.ECDSAPublicKey).Bytes()
+	for _, params := range []password.Params{
+		password.ScryptParams{LogN: 10},
+		password.PBKDF2Params{Iterations: 1000},
+	} {
+		data, err := Encrypt(priv, pw, params)
+		assert.Nil(t, err)
+		assert.Contains(t, string(data), `"address":"`+hex.EncodeToString(address)+`"`)
+		assert.Contains(t, string(data), `"version":3`)
+		decrypted, err := Decrypt(data, pw)
+		assert.Nil(t, err)
+		assert.Equal(t, priv.D, decrypted.D)
+		_, err = Decrypt(data, []byte("wrong"))
+		assert.Equal(t, ErrDecrypt, err)
+	}
+
+	// the address of a AlgoP256K1 key is the one of the recover mode
+	d, _ := priv.Bytes()
+	k1 := new(asym.ECDSAPrivateKey)
+	_ = k1.FromBytes(d, asym.AlgoP256K1)
+	data, err := Encrypt(k1, pw, LightParams)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"address":"`+hex.EncodeToString(address)+`"`)
+	assert.Contains(t, string(data), `"n":4096,"r":8,"p":6`)
+
+	r1, _ := asym.GenerateKey(asym.AlgoP256R1)
+	_, err = Encrypt(r1, pw, LightParams)
+	assert.Equal(t, ErrNotK1Key, err)
+	_, err = Encrypt(priv, pw, password.PBKDF2Params{HashType: hash.SHA2_512})
+	assert.NotNil(t, err)
+	_, err = Encrypt(priv, pw, password.BcryptParams{})
+	assert.NotNil(t, err)
+	_, err = Encrypt(priv, pw, password.PBKDF2Params{Iterations: 20000000})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,56 @@
+package merkle
+
+import (
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+//Builder build a Merkle tree by appending leaves one by one.
+// It only keeps the roots of the perfect subtrees the tree is made of,
+// that is at most log2(size) hashes, so the leaves can be dropped after Append.
+// Builder is not safe for concurrent use.
+type Builder struct {
+	th   *treeHasher
+	size uint64
+	// roots of the perfect subtrees from the biggest to the smallest,
+	// the subtree sizes are the set bits of size
+	subtrees [][]byte
+}
+
+//NewBuilder return a Builder of an empty tree
+func NewBuilder(hashType hash.HashType) (*Builder, error) {
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return nil, err
+	}
+	return &Builder{th: th}, nil
+}
+
+//Append add a leaf to the right of the tree
+func (b *Builder) Append(leaf []byte) {
+	h := b.th.hashLeaf(leaf)
+	for s := b.size; s&1 == 1; s >>= 1 {
+		last := len(b.subtrees) - 1
+		h = b.th.hashChildren(b.subtrees[last], h)
+		b.subtrees = b.subtrees[:last]
+	}
+	b.subtrees = append(b.subtrees, h)
+	b.size++
+}
+
+//Size return the number of appended leaves
+func (b *Builder) Size() uint64 {
+	return b.size
+}
+
+//Root return the Merkle Tree Hash of the appended leaves
+func (b *Builder) Root() []byte {
+	if b.size == 0 {
+		return b.th.emptyRoot()
+	}
+	last := len(b.subtrees) - 1
+	r := append([]byte(nil), b.subtrees[last]...)
+	for i := last - 1; i >= 0; i-- {
+		r = b.th.hashChildren(b.subtrees[i], r)
+	}
+	return r
+}
@@ -0,0 +1,75 @@
+package merkle
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+// domain separation prefixes of RFC 6962 section 2.1, a leaf hash can
+// never be confused with an interior node hash.
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+//error defines
+var (
+	ErrIndexOutOfRange = errors.New("merkle: leaf index out of range")
+	ErrSizeOutOfRange  = errors.New("merkle: tree size out of range")
+	ErrInvalidProof    = errors.New("merkle: invalid proof")
+	ErrRootMismatch    = errors.New("merkle: calculated root mismatch")
+)
+
+// treeHasher computes leaf and interior node hashes, it is not safe for
+// concurrent use because it reuses one Hasher.
+type treeHasher struct {
+	hasher *hash.Hasher
+	prefix [1]byte
+}
+
+func newTreeHasher(hashType hash.HashType) (*treeHasher, error) {
+	h := hash.NewHasher(hashType)
+	if h == nil {
+		return nil, fmt.Errorf("merkle: unsupported hash type 0x%x", uint32(hashType))
+	}
+	return &treeHasher{hasher: h}, nil
+}
+
+// emptyRoot is the hash of an empty tree: MTH({}) = HASH()
+func (th *treeHasher) emptyRoot() []byte {
+	r, _ := th.hasher.Hash(nil)
+	return r
+}
+
+// hashLeaf returns HASH(0x00 || leaf)
+func (th *treeHasher) hashLeaf(leaf []byte) []byte {
+	th.prefix[0] = leafPrefix
+	r, _ := th.hasher.BatchHash([][]byte{th.prefix[:], leaf})
+	return r
+}
+
+// hashChildren returns HASH(0x01 || left || right)
+func (th *treeHasher) hashChildren(left, right []byte) []byte {
+	th.prefix[0] = nodePrefix
+	r, _ := th.hasher.BatchHash([][]byte{th.prefix[:], left, right})
+	return r
+}
+
+func (th *treeHasher) hashLeaves(leaves [][]byte) [][]byte {
+	ret := make([][]byte, len(leaves))
+	for i := range leaves {
+		ret[i] = th.hashLeaf(leaves[i])
+	}
+	return ret
+}
+
+// split returns the largest power of two smaller than n, n must be greater than 1.
+func split(n uint64) uint64 {
+	k := uint64(1)
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
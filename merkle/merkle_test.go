@@ -0,0 +1,158 @@
+package merkle
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+// test data from RFC 6962 reference implementation (certificate-transparency)
+var leaves = []string{"", "00", "10", "2021", "3031", "40414243", "5051525354555657", "606162636465666768696a6b6c6d6e6f"}
+
+var roots = []string{
+	"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+	"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+	"aeb6bcfe274b70a14fb067a5e5578264db0fa9b51af5e0ba159158f329e06e77",
+	"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+	"4e3bbb1f7b478dcfe71fb631631519a3bca12c9aefca1612bfce4c13a86264d4",
+	"76e67dadbcdf1e10e1b74ddc608abd2f98dfb16fbce75277b5232a127f2087ef",
+	"ddb89be403809e325750d3d263cd78929c2942b7942a34b77e122c9594a74c8c",
+	"5dc9da79a70659a9ad559cb701ded9a2ab9d823aad2f4960cfe370eff4604328",
+}
+
+func testLeaves(t *testing.T) [][]byte {
+	ret := make([][]byte, len(leaves))
+	for i := range leaves {
+		var err error
+		ret[i], err = hex.DecodeString(leaves[i])
+		assert.Nil(t, err)
+	}
+	return ret
+}
+
+func decodeAll(in ...string) [][]byte {
+	ret := make([][]byte, len(in))
+	for i := range in {
+		ret[i], _ = hex.DecodeString(in[i])
+	}
+	return ret
+}
+
+func TestRoot(t *testing.T) {
+	data := testLeaves(t)
+	for i := 0; i <= len(data); i++ {
+		root, err := Root(hash.SHA2_256, data[:i])
+		assert.Nil(t, err)
+		assert.Equal(t, roots[i], hex.EncodeToString(root))
+	}
+	_, err := Root(hash.HashType(0xff), data)
+	assert.NotNil(t, err)
+}
+
+func TestBuilder(t *testing.T) {
+	data := testLeaves(t)
+	b, err := NewBuilder(hash.SHA2_256)
+	assert.Nil(t, err)
+	assert.Equal(t, roots[0], hex.EncodeToString(b.Root()))
+	for i := range data {
+		b.Append(data[i])
+		assert.Equal(t, uint64(i+1), b.Size())
+		assert.Equal(t, roots[i+1], hex.EncodeToString(b.Root()))
+	}
+
+	// compare with Root on a bigger tree with another algorithm
+	b, err = NewBuilder(hash.KECCAK_256)
+	assert.Nil(t, err)
+	var all [][]byte
+	for i := 0; i < 100; i++ {
+		leaf := []byte{byte(i), byte(i >> 8)}
+		all = append(all, leaf)
+		b.Append(leaf)
+		expect, err := Root(hash.KECCAK_256, all)
+		assert.Nil(t, err)
+		assert.Equal(t, expect, b.Root())
+	}
+}
+
+func TestInclusionProof(t *testing.T) {
+	data := testLeaves(t)
+	tests := []struct {
+		index, size uint64
+		proof       [][]byte
+	}{
+		{0, 1, [][]byte{}},
+		{0, 8, decodeAll("96a296d224f285c67bee93c30f8a309157f0daa35dc5b87e410b78630a09cfc7",
+			"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+			"6b47aaf29ee3c2af9af889bc1fb9254dabd31177f16232dd6aab035ca39bf6e4")},
+		{5, 7, decodeAll("bc1a0643b12e4d2d7c77918f44e0f4f79a838b6cf9ec5b5c283e1f4d88599e6b",
+			"b08693ec2e721597130641e8211e7eedccb4c26413963eee6c1e2ed16ffb1a5f",
+			"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7")},
+	}
+	for _, tt := range tests {
+		proof, err := InclusionProof(hash.SHA2_256, data[:tt.size], tt.index)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.proof, proof)
+	}
+
+	for size := uint64(1); size <= uint64(len(data)); size++ {
+		root, _ := hex.DecodeString(roots[size])
+		for index := uint64(0); index < size; index++ {
+			proof, err := InclusionProof(hash.SHA2_256, data[:size], index)
+			assert.Nil(t, err)
+			assert.Nil(t, VerifyInclusion(hash.SHA2_256, index, size, data[index], proof, root))
+			assert.NotNil(t, VerifyInclusion(hash.SHA2_256, index, size, []byte("other"), proof, root))
+			if len(proof) > 0 {
+				assert.NotNil(t, VerifyInclusion(hash.SHA2_256, index, size, data[index], proof[1:], root))
+				tampered := append([][]byte{root}, proof[1:]...)
+				assert.NotNil(t, VerifyInclusion(hash.SHA2_256, index, size, data[index], tampered, root))
+			}
+		}
+	}
+	_, err := InclusionProof(hash.SHA2_256, data, 8)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+func TestConsistencyProof(t *testing.T) {
+	data := testLeaves(t)
+	tests := []struct {
+		size1, size2 uint64
+		proof        [][]byte
+	}{
+		{1, 1, [][]byte{}},
+		{1, 8, decodeAll("96a296d224f285c67bee93c30f8a309157f0daa35dc5b87e410b78630a09cfc7",
+			"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+			"6b47aaf29ee3c2af9af889bc1fb9254dabd31177f16232dd6aab035ca39bf6e4")},
+		{6, 8, decodeAll("0ebc5d3437fbe2db158b9f126a1d118e308181031d0a949f8dededebc558ef6a",
+			"ca854ea128ed050b41b35ffc1b87b8eb2bde461e9e3b5596ece6b9d5975a0ae0",
+			"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7")},
+		{3, 7, decodeAll("0298d122906dcfc10892cb53a73992fc5b9f493ea4c9badb27b791b4127a7fe7",
+			"07506a85fd9dd2f120eb694f86011e5bb4662e5c415a62917033d4a9624487e7",
+			"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+			"837dbb152e9b079010717e84e865da4ebc0fa198a806d59d31bf15accef22d0e")},
+	}
+	for _, tt := range tests {
+		proof, err := ConsistencyProof(hash.SHA2_256, data[:tt.size2], tt.size1)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.proof, proof)
+	}
+
+	for size2 := uint64(1); size2 <= uint64(len(data)); size2++ {
+		root2, _ := hex.DecodeString(roots[size2])
+		for size1 := uint64(0); size1 <= size2; size1++ {
+			root1, _ := hex.DecodeString(roots[size1])
+			proof, err := ConsistencyProof(hash.SHA2_256, data[:size2], size1)
+			assert.Nil(t, err)
+			assert.Nil(t, VerifyConsistency(hash.SHA2_256, size1, size2, proof, root1, root2))
+			if size1 > 0 && size1 < size2 {
+				assert.NotNil(t, VerifyConsistency(hash.SHA2_256, size1, size2, proof, root2, root2))
+				assert.NotNil(t, VerifyConsistency(hash.SHA2_256, size1, size2, proof[1:], root1, root2))
+				assert.NotNil(t, VerifyConsistency(hash.SHA2_256, size1, size2, proof, root1, root1))
+			}
+		}
+	}
+	_, err := ConsistencyProof(hash.SHA2_256, data, 9)
+	assert.Equal(t, ErrSizeOutOfRange, err)
+}
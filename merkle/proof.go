@@ -0,0 +1,108 @@
+package merkle
+
+import (
+	"bytes"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+//VerifyInclusion check that leaf is the index-th leaf of the tree with size leaves and the specific root,
+// proof is produced by InclusionProof. The algorithm is in RFC 9162 section 2.1.3.2.
+func VerifyInclusion(hashType hash.HashType, index, size uint64, leaf []byte, proof [][]byte, root []byte) error {
+	if index >= size {
+		return ErrIndexOutOfRange
+	}
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return err
+	}
+	fn, sn := index, size-1
+	r := th.hashLeaf(leaf)
+	for _, p := range proof {
+		if sn == 0 {
+			return ErrInvalidProof
+		}
+		if fn&1 == 1 || fn == sn {
+			r = th.hashChildren(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = th.hashChildren(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 {
+		return ErrInvalidProof
+	}
+	if !bytes.Equal(r, root) {
+		return ErrRootMismatch
+	}
+	return nil
+}
+
+//VerifyConsistency check that the tree of size1 leaves with root1 is a prefix of the tree of size2 leaves with root2,
+// proof is produced by ConsistencyProof. The algorithm is in RFC 9162 section 2.1.4.2.
+func VerifyConsistency(hashType hash.HashType, size1, size2 uint64, proof [][]byte, root1, root2 []byte) error {
+	if size1 > size2 {
+		return ErrSizeOutOfRange
+	}
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return err
+	}
+	if size1 == size2 || size1 == 0 {
+		// nothing to prove, the proof must be empty
+		if len(proof) != 0 {
+			return ErrInvalidProof
+		}
+		if size1 == size2 && !bytes.Equal(root1, root2) {
+			return ErrRootMismatch
+		}
+		if size1 == 0 && !bytes.Equal(root1, th.emptyRoot()) {
+			return ErrRootMismatch
+		}
+		return nil
+	}
+	if len(proof) == 0 {
+		return ErrInvalidProof
+	}
+
+	path := proof
+	if size1&(size1-1) == 0 {
+		// size1 is an exact power of 2, the old root is a node of the new tree
+		path = append([][]byte{root1}, proof...)
+	}
+	fn, sn := size1-1, size2-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+	fr, sr := path[0], path[0]
+	for _, c := range path[1:] {
+		if sn == 0 {
+			return ErrInvalidProof
+		}
+		if fn&1 == 1 || fn == sn {
+			fr = th.hashChildren(c, fr)
+			sr = th.hashChildren(c, sr)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = th.hashChildren(sr, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 {
+		return ErrInvalidProof
+	}
+	if !bytes.Equal(fr, root1) || !bytes.Equal(sr, root2) {
+		return ErrRootMismatch
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Package merkle implements the binary Merkle tree of RFC 6962 over the
+// algorithms of package hash, together with inclusion and consistency proofs.
+package merkle
+
+import (
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+//Root compute the Merkle Tree Hash MTH(leaves) of RFC 6962 section 2.1
+func Root(hashType hash.HashType, leaves [][]byte) ([]byte, error) {
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return th.emptyRoot(), nil
+	}
+	return th.subtreeRoot(th.hashLeaves(leaves)), nil
+}
+
+//InclusionProof return the audit path PATH(index, leaves) of RFC 6962 section 2.1.1,
+// which proves leaves[index] is included in the tree of all leaves.
+func InclusionProof(hashType hash.HashType, leaves [][]byte, index uint64) ([][]byte, error) {
+	if index >= uint64(len(leaves)) {
+		return nil, ErrIndexOutOfRange
+	}
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return nil, err
+	}
+	return th.path(index, th.hashLeaves(leaves)), nil
+}
+
+//ConsistencyProof return the proof PROOF(size, leaves) of RFC 6962 section 2.1.2,
+// which proves the tree of the first size leaves is a prefix of the tree of all leaves.
+func ConsistencyProof(hashType hash.HashType, leaves [][]byte, size uint64) ([][]byte, error) {
+	if size > uint64(len(leaves)) {
+		return nil, ErrSizeOutOfRange
+	}
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 || size == uint64(len(leaves)) {
+		return [][]byte{}, nil
+	}
+	return th.subproof(size, th.hashLeaves(leaves), true), nil
+}
+
+// subtreeRoot computes MTH over leaf hashes, hashes must not be empty.
+func (th *treeHasher) subtreeRoot(hashes [][]byte) []byte {
+	n := uint64(len(hashes))
+	if n == 1 {
+		return hashes[0]
+	}
+	k := split(n)
+	return th.hashChildren(th.subtreeRoot(hashes[:k]), th.subtreeRoot(hashes[k:]))
+}
+
+func (th *treeHasher) path(m uint64, hashes [][]byte) [][]byte {
+	n := uint64(len(hashes))
+	if n == 1 {
+		return [][]byte{}
+	}
+	k := split(n)
+	if m < k {
+		return append(th.path(m, hashes[:k]), th.subtreeRoot(hashes[k:]))
+	}
+	return append(th.path(m-k, hashes[k:]), th.subtreeRoot(hashes[:k]))
+}
+
+func (th *treeHasher) subproof(m uint64, hashes [][]byte, complete bool) [][]byte {
+	n := uint64(len(hashes))
+	if m == n {
+		if complete {
+			return [][]byte{}
+		}
+		return [][]byte{th.subtreeRoot(hashes)}
+	}
+	k := split(n)
+	if m <= k {
+		return append(th.subproof(m, hashes[:k], complete), th.subtreeRoot(hashes[k:]))
+	}
+	return append(th.subproof(m-k, hashes[k:], false), th.subtreeRoot(hashes[:k]))
+}
@@ -0,0 +1,134 @@
+package multihash
+
+import (
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+//Base is a multibase encoding, its value is the prefix character of the encoded strings
+type Base byte
+
+//nolint
+const (
+	//Base58BTC base58 with the bitcoin alphabet, the usual encoding of multihashes
+	Base58BTC Base = 'z'
+	//Base32 lowercase RFC 4648 base32 without padding
+	Base32 Base = 'b'
+)
+
+//error defines
+var (
+	ErrUnsupportedBase = errors.New("multihash: unsupported multibase encoding")
+	ErrInvalidString   = errors.New("multihash: invalid encoded string")
+)
+
+const b58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	b32Encoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+	b58Radix    = big.NewInt(58)
+	// b58Index maps characters back to their value, -1 for characters out of the alphabet
+	b58Index = func() (ret [256]int8) {
+		for i := range ret {
+			ret[i] = -1
+		}
+		for i := 0; i < len(b58Alphabet); i++ {
+			ret[b58Alphabet[i]] = int8(i)
+		}
+		return ret
+	}()
+)
+
+//EncodeString encode the multihash mh as a multibase string, the input is not validated
+func EncodeString(mh []byte, base Base) (string, error) {
+	switch base {
+	case Base58BTC:
+		return string(base) + EncodeBase58(mh), nil
+	case Base32:
+		return string(base) + b32Encoding.EncodeToString(mh), nil
+	default:
+		return "", ErrUnsupportedBase
+	}
+}
+
+//DecodeString decode a multibase string and check it is a valid multihash. For compatibility
+// the legacy bare base58btc form, e.g. "Qm..." for sha2-256, is accepted as well.
+func DecodeString(s string) ([]byte, error) {
+	mh, err := decodeMultibase(s)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := Decode(mh); err != nil {
+		return nil, err
+	}
+	return mh, nil
+}
+
+// decodeMultibase decodes the multibase string s
+func decodeMultibase(s string) ([]byte, error) {
+	if len(s) == 0 {
+		return nil, ErrInvalidString
+	}
+	// a bare base58 sha2-256 multihash always starts with "Qm", which is
+	// not a multibase prefix
+	if strings.HasPrefix(s, "Qm") && len(s) == 46 {
+		return DecodeBase58(s)
+	}
+	switch Base(s[0]) {
+	case Base58BTC:
+		return DecodeBase58(s[1:])
+	case Base32:
+		ret, err := b32Encoding.DecodeString(s[1:])
+		if err != nil {
+			return nil, ErrInvalidString
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("multihash: unsupported multibase prefix %q", s[0])
+	}
+}
+
+//EncodeBase58 encode b with the bitcoin base58 alphabet, leading zero bytes become '1'
+func EncodeBase58(b []byte) string {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+	// log(256) / log(58) < 1.37
+	out := make([]byte, 0, zeros+len(b)*137/100+1)
+	x := new(big.Int).SetBytes(b[zeros:])
+	mod := new(big.Int)
+	for x.Sign() > 0 {
+		x.DivMod(x, b58Radix, mod)
+		out = append(out, b58Alphabet[mod.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		out = append(out, b58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+//DecodeBase58 decode a bitcoin base58 string, leading '1' become zero bytes
+func DecodeBase58(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == b58Alphabet[0] {
+		zeros++
+	}
+	x := new(big.Int)
+	digit := new(big.Int)
+	for i := zeros; i < len(s); i++ {
+		v := b58Index[s[i]]
+		if v < 0 {
+			return nil, ErrInvalidString
+		}
+		x.Mul(x, b58Radix)
+		x.Add(x, digit.SetInt64(int64(v)))
+	}
+	return append(make([]byte, zeros), x.Bytes()...), nil
+}
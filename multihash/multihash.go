@@ -0,0 +1,231 @@
+//Package multihash encodes digests as self-describing multihashes for content addressing,
+// see https://multiformats.io/multihash/. A multihash is
+//
+//	varint(code) || varint(length) || digest
+//
+// where code identifies the hash function in the multicodec table. Multihashes are
+// usually exchanged as multibase strings, e.g. base58btc "z..." or base32 "b...".
+package multihash
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+// maxVarintLen is the longest unsigned varint allowed by the multiformats spec
+const maxVarintLen = 9
+
+//MinDigestLen is the length CheckTruncation requires at least, shorter prefixes are easy to collide
+const MinDigestLen = 16
+
+//error defines
+var (
+	ErrTooShort       = errors.New("multihash: too short")
+	ErrInvalidVarint  = errors.New("multihash: invalid varint")
+	ErrLengthMismatch = errors.New("multihash: digest length mismatch")
+	ErrDigestMismatch = errors.New("multihash: digest mismatch")
+	ErrEmptyDigest    = errors.New("multihash: empty digest")
+	ErrDigestTooShort = errors.New("multihash: digest is truncated below MinDigestLen")
+)
+
+//UnknownCodeError is returned if a multihash code is not mapped to a hash type
+type UnknownCodeError uint64
+
+func (e UnknownCodeError) Error() string {
+	return fmt.Sprintf("multihash: unknown code 0x%x", uint64(e))
+}
+
+//UnsupportedHashError is returned if a hash type has no multihash code
+type UnsupportedHashError hash.HashType
+
+func (e UnsupportedHashError) Error() string {
+	return fmt.Sprintf("multihash: no code for hash type %v", hash.HashType(e))
+}
+
+var (
+	codesLock sync.RWMutex
+	// codes and types map between the multicodec table and hash types
+	codes = map[hash.HashType]uint64{
+		hash.SHA1:       0x11,
+		hash.SHA2_224:   0x1013,
+		hash.SHA2_256:   0x12,
+		hash.SHA2_384:   0x20,
+		hash.SHA2_512:   0x13,
+		hash.SHA3_224:   0x17,
+		hash.SHA3_256:   0x16,
+		hash.SHA3_384:   0x15,
+		hash.SHA3_512:   0x14,
+		hash.KECCAK_224: 0x1a,
+		hash.KECCAK_256: 0x1b,
+		hash.KECCAK_384: 0x1c,
+		hash.KECCAK_512: 0x1d,
+	}
+	types = func() map[uint64]hash.HashType {
+		ret := make(map[uint64]hash.HashType, len(codes))
+		for t, c := range codes {
+			ret[c] = t
+		}
+		return ret
+	}()
+)
+
+//RegisterCode map a multicodec code to a hash type, usually one added by hash.RegisterHash.
+// It returns an error if code or hashType is already mapped.
+func RegisterCode(code uint64, hashType hash.HashType) error {
+	codesLock.Lock()
+	defer codesLock.Unlock()
+	if _, ok := types[code]; ok {
+		return fmt.Errorf("multihash: code 0x%x is already registered", code)
+	}
+	if _, ok := codes[hashType]; ok {
+		return fmt.Errorf("multihash: hash type %v is already registered", hashType)
+	}
+	codes[hashType] = code
+	types[code] = hashType
+	return nil
+}
+
+//Code return the multicodec code of hashType
+func Code(hashType hash.HashType) (uint64, error) {
+	codesLock.RLock()
+	code, ok := codes[hashType]
+	codesLock.RUnlock()
+	if !ok {
+		return 0, UnsupportedHashError(hashType)
+	}
+	return code, nil
+}
+
+//HashTypeOf return the hash type of a multicodec code
+func HashTypeOf(code uint64) (hash.HashType, error) {
+	codesLock.RLock()
+	hashType, ok := types[code]
+	codesLock.RUnlock()
+	if !ok {
+		return 0, UnknownCodeError(code)
+	}
+	return hashType, nil
+}
+
+//Decoded is a parsed multihash
+type Decoded struct {
+	Code     uint64
+	HashType hash.HashType
+	// Digest may be shorter than the output of HashType if it was truncated
+	Digest []byte
+}
+
+//Encode prefix digest, the output of a Hasher of hashType, with its code and length.
+// The digest may be truncated, it must not be empty nor longer than the output of hashType.
+func Encode(hashType hash.HashType, digest []byte) ([]byte, error) {
+	code, err := Code(hashType)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkLength(hashType, len(digest)); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, 2*binary.MaxVarintLen64+len(digest))
+	var tmp [binary.MaxVarintLen64]byte
+	buf = append(buf, tmp[:binary.PutUvarint(tmp[:], code)]...)
+	buf = append(buf, tmp[:binary.PutUvarint(tmp[:], uint64(len(digest)))]...)
+	return append(buf, digest...), nil
+}
+
+//Sum hash data with hashType and return the multihash of the digest
+func Sum(hashType hash.HashType, data []byte) ([]byte, error) {
+	if _, err := Code(hashType); err != nil {
+		return nil, err
+	}
+	digest, err := hash.Sum(hashType, data)
+	if err != nil {
+		return nil, err
+	}
+	return Encode(hashType, digest)
+}
+
+//Decode parse a multihash, the code must be mapped to a hash type and the length must
+// match the rest of mh. As the spec allows, the digest may be truncated to any length but
+// not be empty, use CheckTruncation to require MinDigestLen bytes. The returned digest aliases mh.
+func Decode(mh []byte) (*Decoded, error) {
+	code, n, err := uvarint(mh)
+	if err != nil {
+		return nil, err
+	}
+	mh = mh[n:]
+	length, n, err := uvarint(mh)
+	if err != nil {
+		return nil, err
+	}
+	mh = mh[n:]
+	if uint64(len(mh)) != length {
+		return nil, ErrLengthMismatch
+	}
+	hashType, err := HashTypeOf(code)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkLength(hashType, len(mh)); err != nil {
+		return nil, err
+	}
+	return &Decoded{Code: code, HashType: hashType, Digest: mh}, nil
+}
+
+//CheckTruncation return ErrDigestTooShort if the digest is truncated below MinDigestLen bytes
+func (d *Decoded) CheckTruncation() error {
+	if len(d.Digest) >= MinDigestLen {
+		return nil
+	}
+	if h := hash.NewHasher(d.HashType); h == nil || len(d.Digest) < h.Size() {
+		return ErrDigestTooShort
+	}
+	return nil
+}
+
+//Verify check that mh is the multihash of data, truncated digests are compared by their prefix
+func Verify(mh, data []byte) error {
+	d, err := Decode(mh)
+	if err != nil {
+		return err
+	}
+	digest, err := hash.Sum(d.HashType, data)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(digest[:len(d.Digest)], d.Digest) {
+		return ErrDigestMismatch
+	}
+	return nil
+}
+
+// checkLength rejects an empty digest, and a digest longer than the output of hashType if it has a Hasher
+func checkLength(hashType hash.HashType, n int) error {
+	if n == 0 {
+		return ErrEmptyDigest
+	}
+	if h := hash.NewHasher(hashType); h != nil && n > h.Size() {
+		return ErrLengthMismatch
+	}
+	return nil
+}
+
+// uvarint reads a minimally encoded unsigned varint of at most maxVarintLen bytes
+func uvarint(buf []byte) (uint64, int, error) {
+	if len(buf) == 0 {
+		return 0, 0, ErrTooShort
+	}
+	v, n := binary.Uvarint(buf)
+	if n == 0 {
+		return 0, 0, ErrTooShort
+	}
+	// overflow, too long or not minimal, e.g. 0x80 0x00 for 0
+	if n < 0 || n > maxVarintLen || (n > 1 && buf[n-1] == 0) {
+		return 0, 0, ErrInvalidVarint
+	}
+	return v, n, nil
+}
@@ -0,0 +1,162 @@
+package multihash
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	// sha2-256 of "foo", the usual IPFS example
+	mh, err := Sum(hash.SHA2_256, []byte("foo"))
+	assert.Nil(t, err)
+	assert.Equal(t, "12202c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", hex.EncodeToString(mh))
+	s, err := EncodeString(mh, Base58BTC)
+	assert.Nil(t, err)
+	assert.Equal(t, "zQmRJzsvyCQyizr73Gmms8ZRtvNxmgqumxc2KUp71dfEmoj", s)
+
+	for _, s := range []string{s, s[1:], "bciqcyjvunnup7rup7gnukpa5gbatie2cfvygja57ud4yuxuimjtoplq"} {
+		decoded, err := DecodeString(s)
+		assert.Nil(t, err, s)
+		assert.Equal(t, mh, decoded)
+	}
+	encoded, err := EncodeString(mh, Base32)
+	assert.Nil(t, err)
+	assert.Equal(t, "bciqcyjvunnup7rup7gnukpa5gbatie2cfvygja57ud4yuxuimjtoplq", encoded)
+
+	// sha2-224 has a two bytes code
+	mh, err = Sum(hash.SHA2_224, []byte("foo"))
+	assert.Nil(t, err)
+	assert.Equal(t, "93201c", hex.EncodeToString(mh[:3]))
+
+	for _, typ := range []hash.HashType{hash.SHA1, hash.SHA2_384, hash.SHA2_512, hash.SHA3_224, hash.SHA3_256,
+		hash.SHA3_384, hash.SHA3_512, hash.KECCAK_224, hash.KECCAK_256, hash.KECCAK_384, hash.KECCAK_512} {
+		mh, err := Sum(typ, []byte("foo"))
+		assert.Nil(t, err)
+		d, err := Decode(mh)
+		assert.Nil(t, err)
+		assert.Equal(t, typ, d.HashType)
+		expect, _ := hash.Sum(typ, []byte("foo"))
+		assert.Equal(t, expect, d.Digest)
+		assert.Nil(t, Verify(mh, []byte("foo")))
+		assert.Equal(t, ErrDigestMismatch, Verify(mh, []byte("bar")))
+	}
+	code, err := Code(hash.KECCAK_256)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0x1b), code)
+
+	_, err = Sum(hash.HashType(0xff), nil)
+	assert.Equal(t, UnsupportedHashError(0xff), err)
+}
+
+func TestDecode(t *testing.T) {
+	digest, _ := hash.Sum(hash.SHA3_256, []byte("foo"))
+	// truncated digests are allowed
+	mh, err := Encode(hash.SHA3_256, digest[:20])
+	assert.Nil(t, err)
+	d, err := Decode(mh)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0x16), d.Code)
+	assert.Equal(t, digest[:20], d.Digest)
+	assert.Nil(t, Verify(mh, []byte("foo")))
+
+	_, err = Encode(hash.SHA3_256, append(digest, 0))
+	assert.Equal(t, ErrLengthMismatch, err)
+	// an empty digest would verify any data
+	_, err = Encode(hash.SHA3_256, nil)
+	assert.Equal(t, ErrEmptyDigest, err)
+	assert.Nil(t, d.CheckTruncation())
+
+	// any truncation is valid, CheckTruncation rejects the short ones
+	for _, l := range []int{1, MinDigestLen - 1, MinDigestLen} {
+		mh, err = Encode(hash.SHA3_256, digest[:l])
+		assert.Nil(t, err)
+		d, err = Decode(mh)
+		assert.Nil(t, err)
+		assert.Nil(t, Verify(mh, []byte("foo")))
+		if l < MinDigestLen {
+			assert.Equal(t, ErrDigestTooShort, d.CheckTruncation())
+		} else {
+			assert.Nil(t, d.CheckTruncation())
+		}
+	}
+	// the full digest of a short hash
+	mh, _ = Sum(hash.SHA1, []byte("foo"))
+	d, _ = Decode(mh)
+	assert.Nil(t, d.CheckTruncation())
+
+	cases := []struct {
+		mh  string
+		err error
+	}{
+		{"", ErrTooShort},
+		{"16", ErrTooShort},
+		{"8080", ErrTooShort},
+		// non-minimal varint
+		{"9600" + "00", ErrInvalidVarint},
+		{"16" + "03" + "0102", ErrLengthMismatch},
+		{"16" + "01" + "0102", ErrLengthMismatch},
+		{"16" + "21" + hex.EncodeToString(append(digest, 0)), ErrLengthMismatch},
+		{"16" + "00", ErrEmptyDigest},
+		{"ff01" + "00", UnknownCodeError(0xff)},
+	}
+	for _, c := range cases {
+		b, _ := hex.DecodeString(c.mh)
+		_, err := Decode(b)
+		assert.Equal(t, c.err, err, c.mh)
+		assert.Equal(t, c.err, Verify(b, []byte("foo")), c.mh)
+	}
+
+	_, err = DecodeString("z0OIl")
+	assert.Equal(t, ErrInvalidString, err)
+	_, err = DecodeString("mEiAsJrRraP/Gj/mbRTwdMEE0E0ItcGSDv6D5il6IYmbnrg")
+	assert.NotNil(t, err)
+	_, err = EncodeString(digest, Base('m'))
+	assert.Equal(t, ErrUnsupportedBase, err)
+}
+
+func TestRegisterCode(t *testing.T) {
+	const custom = hash.HashType(0x7e)
+	assert.Nil(t, RegisterCode(0x300000, custom))
+	assert.NotNil(t, RegisterCode(0x300000, hash.HashType(0x7d)))
+	assert.NotNil(t, RegisterCode(0x12, hash.HashType(0x7d)))
+	assert.NotNil(t, RegisterCode(0x300001, custom))
+
+	// no hasher is registered for custom, so only the length is checked
+	mh, err := Encode(custom, []byte{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, "8080c00103010203", hex.EncodeToString(mh))
+	d, err := Decode(mh)
+	assert.Nil(t, err)
+	assert.Equal(t, custom, d.HashType)
+	assert.Equal(t, ErrDigestTooShort, d.CheckTruncation())
+	_, err = Encode(custom, nil)
+	assert.Equal(t, ErrEmptyDigest, err)
+}
+
+func TestBase58(t *testing.T) {
+	// test data from bitcoin core base58_encode_decode.json
+	cases := [][2]string{
+		{"", ""},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"636363", "aPEr"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+		{"516b6fcd0f", "ABnLTmg"},
+		{"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+		{"572e4794", "3EFU7m"},
+		{"ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+		{"10c8511e", "Rt5zm"},
+		{"00000000000000000000", "1111111111"},
+	}
+	for _, c := range cases {
+		b, _ := hex.DecodeString(c[0])
+		assert.Equal(t, c[1], EncodeBase58(b))
+		decoded, err := DecodeBase58(c[1])
+		assert.Nil(t, err)
+		assert.Equal(t, c[0], hex.EncodeToString(decoded))
+	}
+}
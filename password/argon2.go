@@ -0,0 +1,76 @@
+package password
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idID = "argon2id"
+
+//Argon2idParams the parameters of Argon2id (RFC 9106), the defaults are the
+// second recommended option of RFC 9106: 3 passes over 64 MiB with 4 lanes.
+type Argon2idParams struct {
+	//Time is the number of passes
+	Time uint32
+	//Memory is the memory size in KiB
+	Memory uint32
+	//Threads is the degree of parallelism
+	Threads uint8
+	SaltLen int
+	KeyLen  int
+}
+
+func (p Argon2idParams) normalize() Params {
+	if p.Time == 0 {
+		p.Time = 3
+	}
+	if p.Memory == 0 {
+		p.Memory = 64 * 1024
+	}
+	if p.Threads == 0 {
+		p.Threads = 4
+	}
+	p.SaltLen = orDefault(p.SaltLen, defaultSaltLen)
+	p.KeyLen = orDefault(p.KeyLen, defaultKeyLen)
+	return p
+}
+
+func (p Argon2idParams) hash(pw []byte) (string, error) {
+	if err := checkLengths(p.SaltLen, p.KeyLen); err != nil {
+		return "", err
+	}
+	if p.Memory < 8*uint32(p.Threads) {
+		return "", fmt.Errorf("password: argon2id memory must be at least 8 KiB per thread")
+	}
+	// the version is a field of its own in the PHC string
+	params := fmt.Sprintf("v=%d$m=%d,t=%d,p=%d", argon2.Version, p.Memory, p.Time, p.Threads)
+	return hashWith(p, argon2idID, params, pw, p.SaltLen, p.KeyLen)
+}
+
+func (p Argon2idParams) derive(pw, salt []byte, keyLen int) ([]byte, error) {
+	return argon2.IDKey(pw, salt, p.Time, p.Memory, p.Threads, uint32(keyLen)), nil
+}
+
+// parseArgon2id parses "v=19", "m=65536,t=3,p=4"
+func parseArgon2id(fields []string, saltLen, keyLen int) (deriver, error) {
+	if len(fields) != 2 {
+		return nil, ErrInvalidHash
+	}
+	v, err := parseFields(fields[0], 32, "v")
+	if err != nil {
+		return nil, err
+	}
+	if v[0] != argon2.Version {
+		return nil, ErrUnsupported
+	}
+	mtp, err := parseFields(fields[1], 32, "m", "t", "p")
+	if err != nil {
+		return nil, err
+	}
+	p := Argon2idParams{Memory: uint32(mtp[0]), Time: uint32(mtp[1]), Threads: uint8(mtp[2]), SaltLen: saltLen, KeyLen: keyLen}
+	if p.Time == 0 || p.Threads == 0 || uint64(p.Threads) != mtp[2] || p.Memory < 8*uint32(p.Threads) {
+		return nil, ErrInvalidHash
+	}
+	return p, nil
+}
@@ -0,0 +1,59 @@
+package password
+
+import (
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptMaxPassword is the number of bytes bcrypt uses, longer passwords
+// would be truncated silently
+const bcryptMaxPassword = 72
+
+//BcryptParams the parameters of bcrypt, the default cost is 12.
+// bcrypt has a fixed salt and key length and is encoded as $2a$<cost>$<salt and hash>.
+type BcryptParams struct {
+	Cost int
+}
+
+func (p BcryptParams) normalize() Params {
+	p.Cost = orDefault(p.Cost, 12)
+	return p
+}
+
+func (p BcryptParams) hash(pw []byte) (string, error) {
+	if len(pw) > bcryptMaxPassword {
+		return "", ErrPasswordTooLong
+	}
+	h, err := bcrypt.GenerateFromPassword(pw, p.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(h), nil
+}
+
+func isBcrypt(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2")
+}
+
+func verifyBcrypt(pw []byte, encoded string) error {
+	if len(pw) > bcryptMaxPassword {
+		return ErrMismatch
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), pw)
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return ErrMismatch
+	}
+	if err != nil {
+		return ErrInvalidHash
+	}
+	return nil
+}
+
+func parseBcrypt(encoded string) (Params, error) {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return nil, ErrInvalidHash
+	}
+	return BcryptParams{Cost: cost}, nil
+}
@@ -0,0 +1,221 @@
+// Package password hashes passwords with the slow, salted key derivation
+// functions Argon2id, scrypt, bcrypt and PBKDF2. Hashes are self-describing
+// strings in the PHC string format
+//
+// $<id>$<param>=<value>,...$<salt>$<hash>
+//
+// with salt and hash in base64 without padding, so they can be stored in one
+// column and verified after the parameters have been changed. bcrypt hashes
+// keep their traditional $2a$<cost>$ format.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultSaltLen = 16
+	defaultKeyLen  = 32
+)
+
+//error defines
+var (
+	ErrMismatch        = errors.New("password: password does not match")
+	ErrInvalidHash     = errors.New("password: invalid encoded hash")
+	ErrUnsupported     = errors.New("password: unsupported algorithm")
+	ErrPasswordTooLong = errors.New("password: bcrypt password longer than 72 bytes")
+)
+
+//Params are the parameters of one algorithm: Argon2idParams, ScryptParams, BcryptParams or PBKDF2Params.
+// A zero field means the default of the algorithm.
+type Params interface {
+	// normalize returns the parameters with the defaults filled in
+	normalize() Params
+	// hash returns the encoded hash of pw
+	hash(pw []byte) (string, error)
+}
+
+// deriver is implemented by the algorithms encoded in the PHC format
+type deriver interface {
+	Params
+	derive(pw, salt []byte, keyLen int) ([]byte, error)
+}
+
+//DefaultParams is used by HashPassword if params is nil
+var DefaultParams Params = Argon2idParams{}
+
+//HashPassword return the encoded hash of pw with a random salt
+func HashPassword(pw []byte, params Params) (string, error) {
+	if params == nil {
+		params = DefaultParams
+	}
+	return params.normalize().hash(pw)
+}
+
+//VerifyPassword check pw against an encoded hash returned by HashPassword,
+// it returns ErrMismatch if pw is wrong.
+func VerifyPassword(pw []byte, encoded string) error {
+	if isBcrypt(encoded) {
+		return verifyBcrypt(pw, encoded)
+	}
+	params, salt, key, err := decode(encoded)
+	if err != nil {
+		return err
+	}
+	derived, err := params.derive(pw, salt, len(key))
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(derived, key) != 1 {
+		return ErrMismatch
+	}
+	return nil
+}
+
+//NeedsRehash report whether encoded was produced with other parameters than params, that is
+// a different algorithm, cost, salt or key length. Call it after a successful VerifyPassword
+// and store a new hash of the password if it returns true.
+func NeedsRehash(encoded string, params Params) (bool, error) {
+	if params == nil {
+		params = DefaultParams
+	}
+	old, err := Parse(encoded)
+	if err != nil {
+		return false, err
+	}
+	return old != params.normalize(), nil
+}
+
+//WithDefaults return params with the defaults of its algorithm filled in, DefaultParams if params is nil
+func WithDefaults(params Params) Params {
+	if params == nil {
+		params = DefaultParams
+	}
+	return params.normalize()
+}
+
+//DeriveKey derive a key of keyLen bytes from pw and salt with the cost of params, for formats
+// that store the salt and the cost themselves, such as encrypted PKCS#8. bcrypt is not supported.
+func DeriveKey(pw, salt []byte, keyLen int, params Params) ([]byte, error) {
+	d, ok := WithDefaults(params).(deriver)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return d.derive(pw, salt, keyLen)
+}
+
+//Parse return the parameters an encoded hash was produced with
+func Parse(encoded string) (Params, error) {
+	if isBcrypt(encoded) {
+		return parseBcrypt(encoded)
+	}
+	params, _, _, err := decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// salt returns n random bytes
+func salt(n int) ([]byte, error) {
+	s := make([]byte, n)
+	if _, err := rand.Read(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// encode formats a PHC string
+func encode(id, params string, salt, key []byte) string {
+	return "$" + id + "$" + params + "$" + base64.RawStdEncoding.EncodeToString(salt) +
+		"$" + base64.RawStdEncoding.EncodeToString(key)
+}
+
+// hashWith derives a key of d with a random salt and encodes it
+func hashWith(d deriver, id, params string, pw []byte, saltLen, keyLen int) (string, error) {
+	s, err := salt(saltLen)
+	if err != nil {
+		return "", err
+	}
+	key, err := d.derive(pw, s, keyLen)
+	if err != nil {
+		return "", err
+	}
+	return encode(id, params, s, key), nil
+}
+
+// decode parses a PHC string of one of the derivers
+func decode(encoded string) (d deriver, salt, key []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	// the string starts with '$', so parts[0] is empty
+	if len(parts) < 5 || parts[0] != "" {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	id, fields := parts[1], parts[2:len(parts)-2]
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[len(parts)-2]); err != nil || len(salt) == 0 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[len(parts)-1]); err != nil || len(key) == 0 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	switch {
+	case id == argon2idID:
+		d, err = parseArgon2id(fields, len(salt), len(key))
+	case id == scryptID:
+		d, err = parseScrypt(fields, len(salt), len(key))
+	case id == pbkdf2ID:
+		d, err = parsePBKDF2("", fields, len(salt), len(key))
+	case strings.HasPrefix(id, pbkdf2Prefix):
+		d, err = parsePBKDF2(id[len(pbkdf2Prefix):], fields, len(salt), len(key))
+	default:
+		err = ErrUnsupported
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return d, salt, key, nil
+}
+
+// parseFields parses "k1=v1,k2=v2" with exactly the keys of names into
+// unsigned integers of at most bits bits, in the order of names.
+func parseFields(s string, bits int, names ...string) ([]uint64, error) {
+	kvs := strings.Split(s, ",")
+	if len(kvs) != len(names) {
+		return nil, ErrInvalidHash
+	}
+	ret := make([]uint64, len(names))
+	for i, kv := range kvs {
+		prefix := names[i] + "="
+		if !strings.HasPrefix(kv, prefix) {
+			return nil, ErrInvalidHash
+		}
+		v, err := strconv.ParseUint(kv[len(prefix):], 10, bits)
+		if err != nil {
+			return nil, ErrInvalidHash
+		}
+		ret[i] = v
+	}
+	return ret, nil
+}
+
+// orDefault returns v, or def if v is zero
+func orDefault(v, def int) int {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+// checkLengths rejects salts and keys too short to be secure
+func checkLengths(saltLen, keyLen int) error {
+	if saltLen < 8 || keyLen < 16 {
+		return fmt.Errorf("password: salt of %d bytes or key of %d bytes is too short", saltLen, keyLen)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package password
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+// cheap parameters to keep the tests fast
+var testParams = []Params{
+	Argon2idParams{Time: 1, Memory: 64, Threads: 1},
+	ScryptParams{LogN: 10},
+	BcryptParams{Cost: 4},
+	PBKDF2Params{Iterations: 1000},
+	PBKDF2Params{HashType: hash.KECCAK_256, Iterations: 1000, SaltLen: 32, KeyLen: 64},
+}
+
+func TestHashPassword(t *testing.T) {
+	pw := []byte("correct horse battery staple")
+	for _, params := range testParams {
+		encoded, err := HashPassword(pw, params)
+		assert.Nil(t, err)
+		assert.Nil(t, VerifyPassword(pw, encoded), encoded)
+		assert.Equal(t, ErrMismatch, VerifyPassword([]byte("Correct horse battery staple"), encoded), encoded)
+
+		// salted
+		again, err := HashPassword(pw, params)
+		assert.Nil(t, err)
+		assert.NotEqual(t, encoded, again)
+
+		rehash, err := NeedsRehash(encoded, params)
+		assert.Nil(t, err)
+		assert.False(t, rehash, encoded)
+		parsed, err := Parse(encoded)
+		assert.Nil(t, err)
+		assert.Equal(t, params.normalize(), parsed)
+	}
+
+	encoded, err := HashPassword(pw, testParams[1])
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "$scrypt$ln=10,r=8,p=1$"))
+	rehash, err := NeedsRehash(encoded, ScryptParams{LogN: 11})
+	assert.Nil(t, err)
+	assert.True(t, rehash)
+	rehash, err = NeedsRehash(encoded, testParams[0])
+	assert.Nil(t, err)
+	assert.True(t, rehash)
+	rehash, err = NeedsRehash(encoded, ScryptParams{LogN: 10, KeyLen: 64})
+	assert.Nil(t, err)
+	assert.True(t, rehash)
+
+	encoded, err = HashPassword(pw, testParams[4])
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "$pbkdf2-keccak-256$i=1000$"))
+	for hashType, id := range map[hash.HashType]string{hash.SHA1: "$pbkdf2$", hash.SHA2_256: "$pbkdf2-sha256$", hash.SHA2_512: "$pbkdf2-sha512$"} {
+		encoded, err = HashPassword(pw, PBKDF2Params{HashType: hashType, Iterations: 1000})
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(encoded, id+"i=1000$"), encoded)
+		assert.Nil(t, VerifyPassword(pw, encoded))
+	}
+}
+
+func TestDefaultParams(t *testing.T) {
+	pw := []byte("password")
+	encoded, err := HashPassword(pw, nil)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=65536,t=3,p=4$"))
+	assert.Nil(t, VerifyPassword(pw, encoded))
+	rehash, err := NeedsRehash(encoded, nil)
+	assert.Nil(t, err)
+	assert.False(t, rehash)
+}
+
+func TestDeriveKey(t *testing.T) {
+	// RFC 7914 and RFC 6070
+	key, err := DeriveKey([]byte("password"), []byte("NaCl"), 64, ScryptParams{LogN: 10, P: 16})
+	assert.Nil(t, err)
+	assert.Equal(t, "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640", hex.EncodeToString(key))
+	key, err = DeriveKey([]byte("password"), []byte("salt"), 20, PBKDF2Params{HashType: hash.SHA1, Iterations: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "0c60c80f961f0e71f3a9b524af6012062fe037a6", hex.EncodeToString(key))
+	_, err = DeriveKey([]byte("password"), []byte("salt"), 20, BcryptParams{})
+	assert.Equal(t, ErrUnsupported, err)
+
+	assert.Equal(t, PBKDF2Params{HashType: hash.SHA2_256, Iterations: 600000, SaltLen: 16, KeyLen: 32}, WithDefaults(PBKDF2Params{}))
+	assert.Equal(t, DefaultParams.normalize(), WithDefaults(nil))
+}
+
+// hashes produced by other implementations
+func TestVerifyPassword(t *testing.T) {
+	tests := []struct {
+		pw, encoded string
+	}{
+		// x/crypto argon2 test vector
+		{"password", "$argon2id$v=19$m=256,t=3,p=2$c29tZXNhbHQ$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L"},
+		// RFC 7914 section 12
+		{"password", "$scrypt$ln=10,r=8,p=16$TmFDbA$/bq+HJ00cgB4VucZDQHp/nxq18vII3gw53N2Y0s3MWIurzDZLiKjiG/xCSedmDDaxyevuUqD7m2DYMvfoswGQA"},
+		// OpenBSD bcrypt
+		{"allmine", "$2a$10$XajjQvNhvvRt5GSeFk1xFeyqRrsxkhBkUiQeg0dt.wU1qD4aFDcga"},
+		// RFC 6070, with the identifier of SHA1 and with the key length of RustCrypto
+		{"password", "$pbkdf2$i=1$c2FsdA$DGDID5YfDnHzqbUkr2ASBi/gN6Y"},
+		{"password", "$pbkdf2$i=1,l=20$c2FsdA$DGDID5YfDnHzqbUkr2ASBi/gN6Y"},
+		{"password", "$pbkdf2-sha1$i=1$c2FsdA$DGDID5YfDnHzqbUkr2ASBi/gN6Y"},
+		{"correct horse", "$pbkdf2-sha256$i=1000$MDEyMzQ1Njc4OWFiY2RlZg$cBg8D2DungRB9k76szThf5ehfyBz991ay6PT8Srwk4M"},
+	}
+	for _, test := range tests {
+		assert.Nil(t, VerifyPassword([]byte(test.pw), test.encoded), test.encoded)
+		assert.Equal(t, ErrMismatch, VerifyPassword([]byte(test.pw+"1"), test.encoded), test.encoded)
+	}
+}
+
+func TestInvalidHash(t *testing.T) {
+	for _, encoded := range []string{
+		"",
+		"argon2id$v=19$m=256,t=3,p=2$c29tZXNhbHQ$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L",
+		"$argon2id$v=19$m=256,t=3$c29tZXNhbHQ$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L",
+		"$argon2id$v=19$m=256,t=0,p=2$c29tZXNhbHQ$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L",
+		"$argon2id$m=256,t=3,p=2$c29tZXNhbHQ$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L",
+		"$argon2id$v=19$m=256,t=3,p=2$c29tZXNhbHQ=$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L",
+		"$argon2id$v=19$m=256,t=3,p=2$c29tZXNhbHQ$",
+		"$scrypt$ln=99,r=8,p=1$TmFDbA$/bq+HJ00cgB4VucZDQHp",
+		"$pbkdf2-sha1$i=-1$c2FsdA$DGDID5YfDnHzqbUkr2ASBi/gN6Y",
+		"$pbkdf2$i=1,l=32$c2FsdA$DGDID5YfDnHzqbUkr2ASBi/gN6Y",
+		"$pbkdf2$l=20,i=1$c2FsdA$DGDID5YfDnHzqbUkr2ASBi/gN6Y",
+		"$2a$10$fooo",
+	} {
+		assert.Equal(t, ErrInvalidHash, VerifyPassword([]byte("password"), encoded), encoded)
+	}
+	assert.Equal(t, ErrUnsupported, VerifyPassword(nil, "$argon2i$v=19$m=256,t=3,p=2$c29tZXNhbHQ$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L"))
+	assert.Equal(t, ErrUnsupported, VerifyPassword(nil, "$pbkdf2-md5$i=1$c2FsdA$DGDID5YfDnHzqbUkr2ASBi/gN6Y"))
+	assert.Equal(t, ErrUnsupported, VerifyPassword(nil, "$argon2id$v=16$m=256,t=3,p=2$c29tZXNhbHQ$RmjTCsQYfmh47t6s8P2DxaCjDbLMFu8L"))
+
+	_, err := HashPassword(make([]byte, 73), BcryptParams{Cost: 4})
+	assert.Equal(t, ErrPasswordTooLong, err)
+	_, err = HashPassword(nil, ScryptParams{SaltLen: 4})
+	assert.NotNil(t, err)
+	_, err = HashPassword(nil, PBKDF2Params{HashType: 0xff})
+	assert.Equal(t, hash.UnsupportedHashError(0xff), err)
+}
@@ -0,0 +1,91 @@
+package password
+
+import (
+	"fmt"
+	stdhash "hash"
+	"strings"
+
+	"github.com/meshplus/crypto-standard/hash"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	pbkdf2ID     = "pbkdf2"
+	pbkdf2Prefix = "pbkdf2-"
+)
+
+// pbkdf2IDs are the PHC identifiers other implementations use, e.g. the pbkdf2 crate of RustCrypto
+var pbkdf2IDs = map[hash.HashType]string{
+	hash.SHA1:     pbkdf2ID,
+	hash.SHA2_256: "pbkdf2-sha256",
+	hash.SHA2_512: "pbkdf2-sha512",
+}
+
+//PBKDF2Params the parameters of PBKDF2 with HMAC (RFC 8018) over any registered hash type,
+// the defaults are HMAC-SHA2-256 with 600000 iterations.
+// The PHC identifiers of SHA1, SHA2_256 and SHA2_512 are the usual pbkdf2, pbkdf2-sha256 and pbkdf2-sha512,
+// the other hash types use pbkdf2-<lowercase name of the hash type>, e.g. pbkdf2-sha3-256, which only this
+// package reads. An optional l=<key length> is accepted after i=<iterations>. passlib uses the same
+// identifiers with a bare iteration count and its own base64 alphabet, its strings cannot be exchanged.
+type PBKDF2Params struct {
+	HashType   hash.HashType
+	Iterations int
+	SaltLen    int
+	KeyLen     int
+}
+
+func (p PBKDF2Params) normalize() Params {
+	if p.HashType == 0 {
+		// 0 is not a hash type, SHA1 is 0x10
+		p.HashType = hash.SHA2_256
+	}
+	p.Iterations = orDefault(p.Iterations, 600000)
+	p.SaltLen = orDefault(p.SaltLen, defaultSaltLen)
+	p.KeyLen = orDefault(p.KeyLen, defaultKeyLen)
+	return p
+}
+
+func (p PBKDF2Params) hash(pw []byte) (string, error) {
+	if err := checkLengths(p.SaltLen, p.KeyLen); err != nil {
+		return "", err
+	}
+	if !p.HashType.Available() {
+		return "", hash.UnsupportedHashError(p.HashType)
+	}
+	id, ok := pbkdf2IDs[p.HashType]
+	if !ok {
+		id = pbkdf2Prefix + strings.ToLower(p.HashType.String())
+	}
+	return hashWith(p, id, fmt.Sprintf("i=%d", p.Iterations), pw, p.SaltLen, p.KeyLen)
+}
+
+func (p PBKDF2Params) derive(pw, salt []byte, keyLen int) ([]byte, error) {
+	newHash := func() stdhash.Hash { return hash.NewHasher(p.HashType) }
+	return pbkdf2.Key(pw, salt, p.Iterations, keyLen, newHash), nil
+}
+
+// parsePBKDF2 parses the hash name, empty for SHA1, and "i=600000" or "i=600000,l=32"
+func parsePBKDF2(name string, fields []string, saltLen, keyLen int) (deriver, error) {
+	hashType := hash.SHA1
+	if name != "" {
+		var err error
+		if hashType, err = hash.ParseHashType(name); err != nil {
+			return nil, ErrUnsupported
+		}
+	}
+	if len(fields) != 1 {
+		return nil, ErrInvalidHash
+	}
+	names := []string{"i"}
+	if strings.Contains(fields[0], ",") {
+		names = append(names, "l")
+	}
+	v, err := parseFields(fields[0], 31, names...)
+	if err != nil {
+		return nil, err
+	}
+	if v[0] == 0 || len(v) == 2 && int(v[1]) != keyLen {
+		return nil, ErrInvalidHash
+	}
+	return PBKDF2Params{HashType: hashType, Iterations: int(v[0]), SaltLen: saltLen, KeyLen: keyLen}, nil
+}
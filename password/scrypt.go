@@ -0,0 +1,62 @@
+package password
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const scryptID = "scrypt"
+
+//ScryptParams the parameters of scrypt (RFC 7914), the defaults are N = 2^15, r = 8 and p = 1
+type ScryptParams struct {
+	//LogN is log2 of the CPU/memory cost N
+	LogN uint8
+	//R is the block size
+	R int
+	//P is the parallelization
+	P       int
+	SaltLen int
+	KeyLen  int
+}
+
+func (p ScryptParams) normalize() Params {
+	if p.LogN == 0 {
+		p.LogN = 15
+	}
+	p.R = orDefault(p.R, 8)
+	p.P = orDefault(p.P, 1)
+	p.SaltLen = orDefault(p.SaltLen, defaultSaltLen)
+	p.KeyLen = orDefault(p.KeyLen, defaultKeyLen)
+	return p
+}
+
+func (p ScryptParams) hash(pw []byte) (string, error) {
+	if err := checkLengths(p.SaltLen, p.KeyLen); err != nil {
+		return "", err
+	}
+	params := fmt.Sprintf("ln=%d,r=%d,p=%d", p.LogN, p.R, p.P)
+	return hashWith(p, scryptID, params, pw, p.SaltLen, p.KeyLen)
+}
+
+func (p ScryptParams) derive(pw, salt []byte, keyLen int) ([]byte, error) {
+	if p.LogN >= 63 {
+		return nil, ErrInvalidHash
+	}
+	return scrypt.Key(pw, salt, 1<<p.LogN, p.R, p.P, keyLen)
+}
+
+// parseScrypt parses "ln=15,r=8,p=1"
+func parseScrypt(fields []string, saltLen, keyLen int) (deriver, error) {
+	if len(fields) != 1 {
+		return nil, ErrInvalidHash
+	}
+	v, err := parseFields(fields[0], 31, "ln", "r", "p")
+	if err != nil {
+		return nil, err
+	}
+	if v[0] == 0 || v[0] >= 63 || v[1] == 0 || v[2] == 0 {
+		return nil, ErrInvalidHash
+	}
+	return ScryptParams{LogN: uint8(v[0]), R: int(v[1]), P: int(v[2]), SaltLen: saltLen, KeyLen: keyLen}, nil
+}
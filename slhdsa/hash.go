@@ -0,0 +1,217 @@
+package slhdsa
+
+import (
+	"crypto/hmac"
+	"encoding/binary"
+	stdhash "hash"
+
+	"github.com/meshplus/crypto-standard/hash"
+	sha3Hash "github.com/meshplus/crypto-standard/hash/sha3"
+)
+
+// address types of FIPS 205 section 4.2
+const (
+	addrWOTSHash  = 0
+	addrWOTSPK    = 1
+	addrTree      = 2
+	addrFORSTree  = 3
+	addrFORSRoots = 4
+	addrWOTSPRF   = 5
+	addrFORSPRF   = 6
+)
+
+// address is the 32 bytes ADRS of FIPS 205 section 4.2
+type address [32]byte
+
+func (a *address) setLayer(layer uint32) {
+	binary.BigEndian.PutUint32(a[0:], layer)
+}
+
+func (a *address) setTree(tree uint64) {
+	binary.BigEndian.PutUint32(a[4:], 0)
+	binary.BigEndian.PutUint64(a[8:], tree)
+}
+
+// setTypeAndClear sets the type and clears the words that follow it
+func (a *address) setTypeAndClear(typ uint32) {
+	binary.BigEndian.PutUint32(a[16:], typ)
+	for i := 20; i < 32; i++ {
+		a[i] = 0
+	}
+}
+
+func (a *address) setKeyPair(i uint32) {
+	binary.BigEndian.PutUint32(a[20:], i)
+}
+
+func (a *address) keyPair() uint32 {
+	return binary.BigEndian.Uint32(a[20:])
+}
+
+func (a *address) setChain(i uint32) {
+	binary.BigEndian.PutUint32(a[24:], i)
+}
+
+func (a *address) setTreeHeight(i uint32) {
+	binary.BigEndian.PutUint32(a[24:], i)
+}
+
+func (a *address) setHash(i uint32) {
+	binary.BigEndian.PutUint32(a[28:], i)
+}
+
+func (a *address) setTreeIndex(i uint32) {
+	binary.BigEndian.PutUint32(a[28:], i)
+}
+
+// compressed returns the 22 bytes ADRSc of the SHA2 parameter sets, FIPS 205 section 11.2
+func (a *address) compressed(c *[22]byte) []byte {
+	c[0] = a[3]
+	copy(c[1:9], a[8:16])
+	c[9] = a[19]
+	copy(c[10:], a[20:32])
+	return c[:]
+}
+
+// hasher computes the functions of FIPS 205 section 11 for the PK.seed of one key,
+// it is not safe for concurrent use. PRF(PK.seed, SK.seed, ADRS) has the form of F in
+// both instantiations and is computed by f.
+type hasher interface {
+	// f computes F(PK.seed, ADRS, m) into out[:n], out may alias m
+	f(out []byte, a *address, m []byte)
+	// h computes H and T_l(PK.seed, ADRS, m) into out[:n]
+	h(out []byte, a *address, m []byte)
+	// prfMsg computes PRF_msg(SK.prf, opt_rand, M), M is the concatenation of msg
+	prfMsg(skPRF, optRand []byte, msg [][]byte) []byte
+	// hMsg computes H_msg(R, PK.seed, PK.root, M) of m bytes
+	hMsg(r, pkRoot []byte, msg [][]byte) []byte
+}
+
+func newHasher(p *params, pkSeed []byte) hasher {
+	if p.shake {
+		return &shakeHasher{p: p, pkSeed: pkSeed, s: sha3Hash.NewShake256()}
+	}
+	ret := &sha2Hasher{p: p, pkSeed: pkSeed}
+	ret.s256, ret.seeded256 = seeded(hash.SHA2_256, pkSeed)
+	ret.msgType = hash.SHA2_256
+	ret.s512, ret.seeded512 = ret.s256, ret.seeded256
+	if p.n > 16 {
+		ret.s512, ret.seeded512 = seeded(hash.SHA2_512, pkSeed)
+		ret.msgType = hash.SHA2_512
+	}
+	return ret
+}
+
+// seeded returns a hash of hashType that absorbed PK.seed padded to a block and its state
+func seeded(hashType hash.HashType, pkSeed []byte) (*hash.Hasher, []byte) {
+	h := hash.NewHasher(hashType)
+	_, _ = h.Write(pkSeed)
+	_, _ = h.Write(make([]byte, h.BlockSize()-len(pkSeed)))
+	state, err := h.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return h, state
+}
+
+// sha2Hasher computes the SHA2 instantiation, FIPS 205 sections 11.2.1 and 11.2.2
+type sha2Hasher struct {
+	p      *params
+	pkSeed []byte
+	// s256 and s512 restart from the states seeded256 and seeded512, s512 is s256 for n = 16
+	s256, s512           *hash.Hasher
+	seeded256, seeded512 []byte
+	// msgType is the hash of H_msg and PRF_msg
+	msgType hash.HashType
+	adrsc   [22]byte
+	sum     [64]byte
+}
+
+func (s *sha2Hasher) f(out []byte, a *address, m []byte) {
+	s.tweak(s.s256, s.seeded256, out, a, m)
+}
+
+func (s *sha2Hasher) h(out []byte, a *address, m []byte) {
+	s.tweak(s.s512, s.seeded512, out, a, m)
+}
+
+// tweak computes Trunc_n(SHA-x(PK.seed || toByte(0, b - n) || ADRSc || m))
+func (s *sha2Hasher) tweak(h *hash.Hasher, state, out []byte, a *address, m []byte) {
+	_ = h.UnmarshalBinary(state)
+	_, _ = h.Write(a.compressed(&s.adrsc))
+	_, _ = h.Write(m)
+	copy(out[:s.p.n], h.Sum(s.sum[:0]))
+}
+
+func (s *sha2Hasher) newMsgHash() stdhash.Hash {
+	return hash.NewHasher(s.msgType)
+}
+
+func (s *sha2Hasher) prfMsg(skPRF, optRand []byte, msg [][]byte) []byte {
+	mac := hmac.New(s.newMsgHash, skPRF)
+	_, _ = mac.Write(optRand)
+	for _, m := range msg {
+		_, _ = mac.Write(m)
+	}
+	return mac.Sum(nil)[:s.p.n]
+}
+
+// hMsg computes MGF1-SHA-x(R || PK.seed || SHA-x(R || PK.seed || PK.root || M), m)
+func (s *sha2Hasher) hMsg(r, pkRoot []byte, msg [][]byte) []byte {
+	h := s.newMsgHash()
+	_, _ = h.Write(r)
+	_, _ = h.Write(s.pkSeed)
+	_, _ = h.Write(pkRoot)
+	for _, m := range msg {
+		_, _ = h.Write(m)
+	}
+	seed := append(append(append([]byte(nil), r...), s.pkSeed...), h.Sum(nil)...)
+	ret := make([]byte, 0, s.p.m+h.Size())
+	var counter [4]byte
+	for i := uint32(0); len(ret) < s.p.m; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		h.Reset()
+		_, _ = h.Write(seed)
+		_, _ = h.Write(counter[:])
+		ret = h.Sum(ret)
+	}
+	return ret[:s.p.m]
+}
+
+// shakeHasher computes the SHAKE instantiation, FIPS 205 section 11.1
+type shakeHasher struct {
+	p      *params
+	pkSeed []byte
+	s      sha3Hash.ShakeHash
+}
+
+func (s *shakeHasher) f(out []byte, a *address, m []byte) {
+	s.s.Reset()
+	_, _ = s.s.Write(s.pkSeed)
+	_, _ = s.s.Write(a[:])
+	_, _ = s.s.Write(m)
+	_, _ = s.s.Read(out[:s.p.n])
+}
+
+func (s *shakeHasher) h(out []byte, a *address, m []byte) {
+	s.f(out, a, m)
+}
+
+func (s *shakeHasher) prfMsg(skPRF, optRand []byte, msg [][]byte) []byte {
+	return s.xof(s.p.n, append([][]byte{skPRF, optRand}, msg...))
+}
+
+func (s *shakeHasher) hMsg(r, pkRoot []byte, msg [][]byte) []byte {
+	return s.xof(s.p.m, append([][]byte{r, s.pkSeed, pkRoot}, msg...))
+}
+
+// xof returns size bytes of SHAKE256 of the concatenation of in
+func (s *shakeHasher) xof(size int, in [][]byte) []byte {
+	s.s.Reset()
+	for _, m := range in {
+		_, _ = s.s.Write(m)
+	}
+	ret := make([]byte, size)
+	_, _ = s.s.Read(ret)
+	return ret
+}
@@ -0,0 +1,103 @@
+package slhdsa
+
+//ParamSet is a SLH-DSA parameter set of FIPS 205 table 2
+type ParamSet int
+
+//nolint
+const (
+	SLH_DSA_SHA2_128S ParamSet = iota + 1
+	SLH_DSA_SHAKE_128S
+	SLH_DSA_SHA2_128F
+	SLH_DSA_SHAKE_128F
+	SLH_DSA_SHA2_192S
+	SLH_DSA_SHAKE_192S
+	SLH_DSA_SHA2_192F
+	SLH_DSA_SHAKE_192F
+	SLH_DSA_SHA2_256S
+	SLH_DSA_SHAKE_256S
+	SLH_DSA_SHA2_256F
+	SLH_DSA_SHAKE_256F
+)
+
+// lgW is the number of bits of a WOTS+ digit, w = 16 for every parameter set
+const (
+	lgW = 4
+	w   = 1 << lgW
+	// len2 is the number of checksum digits, 3 for every n of FIPS 205
+	len2 = 3
+)
+
+// params is the parameters of a parameter set, FIPS 205 table 2
+type params struct {
+	name string
+	shake bool
+	n     int
+	// h is the height of the hypertree, d the number of layers, hp = h / d
+	h, d, hp int
+	// a is the height of a FORS tree, k the number of trees
+	a, k int
+	// m is the length of the message digest
+	m int
+}
+
+var paramSets = map[ParamSet]*params{
+	SLH_DSA_SHA2_128S:  {name: "SLH-DSA-SHA2-128s", n: 16, h: 63, d: 7, hp: 9, a: 12, k: 14, m: 30},
+	SLH_DSA_SHAKE_128S: {name: "SLH-DSA-SHAKE-128s", shake: true, n: 16, h: 63, d: 7, hp: 9, a: 12, k: 14, m: 30},
+	SLH_DSA_SHA2_128F:  {name: "SLH-DSA-SHA2-128f", n: 16, h: 66, d: 22, hp: 3, a: 6, k: 33, m: 34},
+	SLH_DSA_SHAKE_128F: {name: "SLH-DSA-SHAKE-128f", shake: true, n: 16, h: 66, d: 22, hp: 3, a: 6, k: 33, m: 34},
+	SLH_DSA_SHA2_192S:  {name: "SLH-DSA-SHA2-192s", n: 24, h: 63, d: 7, hp: 9, a: 14, k: 17, m: 39},
+	SLH_DSA_SHAKE_192S: {name: "SLH-DSA-SHAKE-192s", shake: true, n: 24, h: 63, d: 7, hp: 9, a: 14, k: 17, m: 39},
+	SLH_DSA_SHA2_192F:  {name: "SLH-DSA-SHA2-192f", n: 24, h: 66, d: 22, hp: 3, a: 8, k: 33, m: 42},
+	SLH_DSA_SHAKE_192F: {name: "SLH-DSA-SHAKE-192f", shake: true, n: 24, h: 66, d: 22, hp: 3, a: 8, k: 33, m: 42},
+	SLH_DSA_SHA2_256S:  {name: "SLH-DSA-SHA2-256s", n: 32, h: 64, d: 8, hp: 8, a: 14, k: 22, m: 47},
+	SLH_DSA_SHAKE_256S: {name: "SLH-DSA-SHAKE-256s", shake: true, n: 32, h: 64, d: 8, hp: 8, a: 14, k: 22, m: 47},
+	SLH_DSA_SHA2_256F:  {name: "SLH-DSA-SHA2-256f", n: 32, h: 68, d: 17, hp: 4, a: 9, k: 35, m: 49},
+	SLH_DSA_SHAKE_256F: {name: "SLH-DSA-SHAKE-256f", shake: true, n: 32, h: 68, d: 17, hp: 4, a: 9, k: 35, m: 49},
+}
+
+//String return the name of the parameter set, e.g. SLH-DSA-SHA2-128s
+func (s ParamSet) String() string {
+	if p, ok := paramSets[s]; ok {
+		return p.name
+	}
+	return "unknown"
+}
+
+// len1 is the number of message digits of WOTS+
+func (p *params) len1() int {
+	return 2 * p.n
+}
+
+// wotsLen is the number of WOTS+ chains
+func (p *params) wotsLen() int {
+	return p.len1() + len2
+}
+
+// forsMsgLen is the number of bytes of the digest selecting the FORS leaves
+func (p *params) forsMsgLen() int {
+	return (p.k*p.a + 7) / 8
+}
+
+// treeIdxLen and leafIdxLen are the number of bytes of the digest selecting the hypertree leaf
+func (p *params) treeIdxLen() int {
+	return (p.h - p.hp + 7) / 8
+}
+
+func (p *params) leafIdxLen() int {
+	return (p.hp + 7) / 8
+}
+
+// xmssSigLen is the length of a XMSS signature, a WOTS+ signature and an authentication path
+func (p *params) xmssSigLen() int {
+	return (p.wotsLen() + p.hp) * p.n
+}
+
+// forsSigLen is the length of a FORS signature, k secret values with their authentication paths
+func (p *params) forsSigLen() int {
+	return p.k * (p.a + 1) * p.n
+}
+
+// sigLen is the length of a signature, R || SIG_FORS || SIG_HT
+func (p *params) sigLen() int {
+	return p.n + p.forsSigLen() + p.d*p.xmssSigLen()
+}
@@ -0,0 +1,222 @@
+//Package slhdsa implements the stateless hash-based signature SLH-DSA of FIPS 205 (SPHINCS+)
+// with the SHA2 and SHAKE parameter sets. Unlike XMSS and LMS a key signs any number of
+// messages without keeping state, at the cost of larger and slower signatures.
+package slhdsa
+
+import (
+	std "crypto"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"io"
+)
+
+// maxContextLen is the limit of FIPS 205 on the length of the context string
+const maxContextLen = 255
+
+//error defines
+var (
+	ErrUnsupported     = errors.New("slhdsa: unsupported parameter set")
+	ErrKeyLength       = errors.New("slhdsa: invalid key length")
+	ErrSignatureLength = errors.New("slhdsa: invalid signature length")
+	ErrContextTooLong  = errors.New("slhdsa: context longer than 255 bytes")
+)
+
+//SLHDSAPublicKey SLH-DSA public key, PK.seed || PK.root
+type SLHDSAPublicKey struct {
+	set    ParamSet
+	pkSeed []byte
+	pkRoot []byte
+}
+
+//SLHDSAPrivateKey SLH-DSA private key, SK.seed || SK.prf || PK.seed || PK.root
+type SLHDSAPrivateKey struct {
+	SLHDSAPublicKey
+	skSeed []byte
+	skPRF  []byte
+}
+
+//GenerateKey generate a key of the parameter set set, reader defaults to crypto/rand
+func GenerateKey(set ParamSet, reader io.Reader) (*SLHDSAPrivateKey, error) {
+	p, ok := paramSets[set]
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	if reader == nil {
+		reader = rand.Reader
+	}
+	seeds := make([]byte, 3*p.n)
+	if _, err := io.ReadFull(reader, seeds); err != nil {
+		return nil, err
+	}
+	return keyGenInternal(set, seeds[:p.n], seeds[p.n:2*p.n], seeds[2*p.n:]), nil
+}
+
+// keyGenInternal derives the key of the seeds, FIPS 205 algorithm 18
+func keyGenInternal(set ParamSet, skSeed, skPRF, pkSeed []byte) *SLHDSAPrivateKey {
+	p := paramSets[set]
+	c := &ctx{p: p, h: newHasher(p, pkSeed), skSeed: skSeed}
+	var a address
+	a.setLayer(uint32(p.d - 1))
+	// the root does not depend on the message nor on the leaf
+	_, root := c.xmssSign(make([]byte, p.n), 0, &a)
+	return &SLHDSAPrivateKey{
+		SLHDSAPublicKey: SLHDSAPublicKey{set: set, pkSeed: pkSeed, pkRoot: root},
+		skSeed:          skSeed,
+		skPRF:           skPRF,
+	}
+}
+
+//Bytes return key bytes. Inverse method of FromBytes(k []byte, opt int)
+func (key *SLHDSAPrivateKey) Bytes() ([]byte, error) {
+	if key.skSeed == nil {
+		return nil, ErrKeyLength
+	}
+	ret := make([]byte, 0, 4*len(key.skSeed))
+	ret = append(ret, key.skSeed...)
+	ret = append(ret, key.skPRF...)
+	ret = append(ret, key.pkSeed...)
+	return append(ret, key.pkRoot...), nil
+}
+
+//FromBytes parse a private key of the parameter set opt, Inverse method of Bytes()
+func (key *SLHDSAPrivateKey) FromBytes(k []byte, opt int) error {
+	p, ok := paramSets[ParamSet(opt)]
+	if !ok {
+		return ErrUnsupported
+	}
+	if len(k) != 4*p.n {
+		return ErrKeyLength
+	}
+	k = append([]byte(nil), k...)
+	key.set = ParamSet(opt)
+	key.skSeed, key.skPRF, key.pkSeed, key.pkRoot = k[:p.n], k[p.n:2*p.n], k[2*p.n:3*p.n], k[3*p.n:]
+	return nil
+}
+
+//Public return the SLHDSAPublicKey of the private key
+func (key *SLHDSAPrivateKey) Public() std.PublicKey {
+	pub := key.SLHDSAPublicKey
+	return &pub
+}
+
+//Sign sign msg with the context string k, which may be nil. Signing is hedged with n random
+// bytes of reader, it is deterministic if reader is nil. FIPS 205 algorithm 22.
+func (key *SLHDSAPrivateKey) Sign(k, msg []byte, reader io.Reader) (signature []byte, err error) {
+	if len(k) > maxContextLen {
+		return nil, ErrContextTooLong
+	}
+	p, ok := paramSets[key.set]
+	if !ok || len(key.skSeed) != p.n {
+		return nil, ErrKeyLength
+	}
+	var addrnd []byte
+	if reader != nil {
+		addrnd = make([]byte, p.n)
+		if _, err = io.ReadFull(reader, addrnd); err != nil {
+			return nil, err
+		}
+	}
+	return key.signInternal([][]byte{{0, byte(len(k))}, k, msg}, addrnd), nil
+}
+
+// signInternal signs the concatenation of msg, addrnd is nil for the deterministic variant,
+// FIPS 205 algorithm 19
+func (key *SLHDSAPrivateKey) signInternal(msg [][]byte, addrnd []byte) []byte {
+	p := paramSets[key.set]
+	c := &ctx{p: p, h: newHasher(p, key.pkSeed), skSeed: key.skSeed}
+	if addrnd == nil {
+		addrnd = key.pkSeed
+	}
+	r := c.h.prfMsg(key.skPRF, addrnd, msg)
+	md, idxTree, idxLeaf := splitDigest(p, c.h.hMsg(r, key.pkRoot, msg))
+
+	var a address
+	a.setTree(idxTree)
+	a.setTypeAndClear(addrFORSTree)
+	a.setKeyPair(idxLeaf)
+	sigFORS := c.forsSign(md, &a)
+	pkFORS := c.forsPKFromSig(sigFORS, md, &a)
+
+	sig := make([]byte, 0, p.sigLen())
+	sig = append(sig, r...)
+	sig = append(sig, sigFORS...)
+	return append(sig, c.htSign(pkFORS, idxTree, idxLeaf)...)
+}
+
+// splitDigest splits the output of H_msg into the FORS message and the hypertree leaf
+func splitDigest(p *params, digest []byte) (md []byte, idxTree uint64, idxLeaf uint32) {
+	md, digest = digest[:p.forsMsgLen()], digest[p.forsMsgLen():]
+	for _, b := range digest[:p.treeIdxLen()] {
+		idxTree = idxTree<<8 | uint64(b)
+	}
+	if bits := uint(p.h - p.hp); bits < 64 {
+		idxTree &= 1<<bits - 1
+	}
+	for _, b := range digest[p.treeIdxLen() : p.treeIdxLen()+p.leafIdxLen()] {
+		idxLeaf = idxLeaf<<8 | uint32(b)
+	}
+	idxLeaf &= 1<<uint(p.hp) - 1
+	return
+}
+
+//FromBytes parse a public key of the parameter set opt, The reverse method of Bytes()
+func (key *SLHDSAPublicKey) FromBytes(k []byte, opt int) error {
+	p, ok := paramSets[ParamSet(opt)]
+	if !ok {
+		return ErrUnsupported
+	}
+	if len(k) != 2*p.n {
+		return ErrKeyLength
+	}
+	k = append([]byte(nil), k...)
+	key.set, key.pkSeed, key.pkRoot = ParamSet(opt), k[:p.n], k[p.n:]
+	return nil
+}
+
+//Bytes return key bytes
+func (key *SLHDSAPublicKey) Bytes() ([]byte, error) {
+	if key.pkSeed == nil {
+		return nil, ErrKeyLength
+	}
+	return append(append([]byte(nil), key.pkSeed...), key.pkRoot...), nil
+}
+
+//ParamSet return the parameter set of the key
+func (key *SLHDSAPublicKey) ParamSet() ParamSet {
+	return key.set
+}
+
+//Verify verify the signature of msg with the context string k, FIPS 205 algorithm 24
+func (key *SLHDSAPublicKey) Verify(k, signature, msg []byte) (valid bool, err error) {
+	if len(k) > maxContextLen {
+		return false, ErrContextTooLong
+	}
+	p, ok := paramSets[key.set]
+	if !ok || len(key.pkSeed) != p.n {
+		return false, ErrKeyLength
+	}
+	if len(signature) != p.sigLen() {
+		return false, ErrSignatureLength
+	}
+	return key.verifyInternal([][]byte{{0, byte(len(k))}, k, msg}, signature), nil
+}
+
+// verifyInternal verifies a signature of the concatenation of msg, FIPS 205 algorithm 20
+func (key *SLHDSAPublicKey) verifyInternal(msg [][]byte, sig []byte) bool {
+	p := paramSets[key.set]
+	if len(sig) != p.sigLen() {
+		return false
+	}
+	c := &ctx{p: p, h: newHasher(p, key.pkSeed)}
+	r, sigFORS, sigHT := sig[:p.n], sig[p.n:p.n+p.forsSigLen()], sig[p.n+p.forsSigLen():]
+	md, idxTree, idxLeaf := splitDigest(p, c.h.hMsg(r, key.pkRoot, msg))
+
+	var a address
+	a.setTree(idxTree)
+	a.setTypeAndClear(addrFORSTree)
+	a.setKeyPair(idxLeaf)
+	pkFORS := c.forsPKFromSig(sigFORS, md, &a)
+	root := c.htRoot(pkFORS, sigHT, idxTree, idxLeaf)
+	return subtle.ConstantTimeCompare(root, key.pkRoot) == 1
+}
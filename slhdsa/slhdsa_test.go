@@ -0,0 +1,192 @@
+package slhdsa
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// acvpVectors is a subset of the NIST ACVP SLH-DSA vectors (ACVP-Server v1.1.0.38,
+// SLH-DSA-keyGen/sigGen/sigVer-FIPS205): one key per parameter set, one pure or internal
+// signature per test group, stored as its SHA-256, and the verification cases of the 128s sets.
+type acvpVectors struct {
+	KeyGen []struct {
+		ParameterSet string `json:"parameterSet"`
+		SkSeed       string `json:"skSeed"`
+		SkPrf        string `json:"skPrf"`
+		PkSeed       string `json:"pkSeed"`
+		Sk           string `json:"sk"`
+		Pk           string `json:"pk"`
+	} `json:"keyGen"`
+	SigGen []struct {
+		ParameterSet    string `json:"parameterSet"`
+		Interface       string `json:"signatureInterface"`
+		Deterministic   bool   `json:"deterministic"`
+		Sk              string `json:"sk"`
+		Message         string `json:"message"`
+		Context         string `json:"context"`
+		AddRand         string `json:"additionalRandomness"`
+		SignatureSHA256 string `json:"signatureSHA256"`
+	} `json:"sigGen"`
+	Verify []struct {
+		ParameterSet string `json:"parameterSet"`
+		Interface    string `json:"signatureInterface"`
+		Pk           string `json:"pk"`
+		Message      string `json:"message"`
+		Context      string `json:"context"`
+		Signature    string `json:"signature"`
+		TestPassed   bool   `json:"testPassed"`
+	} `json:"verify"`
+}
+
+func loadVectors(t *testing.T) *acvpVectors {
+	f, err := os.Open("testdata/acvp.json.gz")
+	assert.Nil(t, err)
+	defer func() { _ = f.Close() }()
+	r, err := gzip.NewReader(f)
+	assert.Nil(t, err)
+	v := new(acvpVectors)
+	assert.Nil(t, json.NewDecoder(r).Decode(v))
+	return v
+}
+
+func paramSetByName(t *testing.T, name string) ParamSet {
+	for set, p := range paramSets {
+		if p.name == name {
+			return set
+		}
+	}
+	t.Fatalf("unknown parameter set %s", name)
+	return 0
+}
+
+func unhex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestACVPKeyGen(t *testing.T) {
+	for _, c := range loadVectors(t).KeyGen {
+		set := paramSetByName(t, c.ParameterSet)
+		if testing.Short() && slow(set) {
+			continue
+		}
+		key := keyGenInternal(set, unhex(c.SkSeed), unhex(c.SkPrf), unhex(c.PkSeed))
+		sk, err := key.Bytes()
+		assert.Nil(t, err)
+		assert.Equal(t, unhex(c.Sk), sk, c.ParameterSet)
+		pk, err := key.Public().(*SLHDSAPublicKey).Bytes()
+		assert.Nil(t, err)
+		assert.Equal(t, unhex(c.Pk), pk, c.ParameterSet)
+	}
+}
+
+func TestACVPSign(t *testing.T) {
+	for _, c := range loadVectors(t).SigGen {
+		set := paramSetByName(t, c.ParameterSet)
+		if testing.Short() && slow(set) {
+			continue
+		}
+		key := new(SLHDSAPrivateKey)
+		assert.Nil(t, key.FromBytes(unhex(c.Sk), int(set)))
+		var addrnd []byte
+		if !c.Deterministic {
+			addrnd = unhex(c.AddRand)
+		}
+		msg := [][]byte{unhex(c.Message)}
+		if c.Interface == "external" {
+			ctx := unhex(c.Context)
+			msg = [][]byte{{0, byte(len(ctx))}, ctx, msg[0]}
+		}
+		sig := key.signInternal(msg, addrnd)
+		sum := sha256.Sum256(sig)
+		assert.Equal(t, c.SignatureSHA256, hex.EncodeToString(sum[:]), c.ParameterSet)
+		assert.True(t, key.verifyInternal(msg, sig))
+
+		if c.Interface == "external" && c.Deterministic {
+			sig, err := key.Sign(unhex(c.Context), unhex(c.Message), nil)
+			assert.Nil(t, err)
+			sum = sha256.Sum256(sig)
+			assert.Equal(t, c.SignatureSHA256, hex.EncodeToString(sum[:]), c.ParameterSet)
+		}
+	}
+}
+
+func TestACVPVerify(t *testing.T) {
+	for _, c := range loadVectors(t).Verify {
+		set := paramSetByName(t, c.ParameterSet)
+		pub := new(SLHDSAPublicKey)
+		assert.Nil(t, pub.FromBytes(unhex(c.Pk), int(set)))
+		if c.Interface == "internal" {
+			assert.Equal(t, c.TestPassed, pub.verifyInternal([][]byte{unhex(c.Message)}, unhex(c.Signature)))
+			continue
+		}
+		valid, err := pub.Verify(unhex(c.Context), unhex(c.Signature), unhex(c.Message))
+		// some invalid cases have a truncated or extended signature
+		if err != nil {
+			assert.Equal(t, ErrSignatureLength, err)
+		}
+		assert.Equal(t, c.TestPassed, valid)
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	key, err := GenerateKey(SLH_DSA_SHAKE_128F, nil)
+	assert.Nil(t, err)
+	pub := key.Public().(*SLHDSAPublicKey)
+	assert.Equal(t, SLH_DSA_SHAKE_128F, pub.ParamSet())
+	assert.Equal(t, "SLH-DSA-SHAKE-128f", pub.ParamSet().String())
+
+	msg := []byte("hello world")
+	sig, err := key.Sign([]byte("context"), msg, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 17088, len(sig))
+	again, _ := key.Sign([]byte("context"), msg, nil)
+	assert.Equal(t, sig, again)
+	hedged, err := key.Sign([]byte("context"), msg, bytes.NewReader(make([]byte, 16)))
+	assert.Nil(t, err)
+	assert.NotEqual(t, sig, hedged)
+
+	for _, s := range [][]byte{sig, hedged} {
+		valid, err := pub.Verify([]byte("context"), s, msg)
+		assert.Nil(t, err)
+		assert.True(t, valid)
+	}
+	valid, err := pub.Verify(nil, sig, msg)
+	assert.Nil(t, err)
+	assert.False(t, valid)
+	valid, _ = pub.Verify([]byte("context"), sig, []byte("hello world!"))
+	assert.False(t, valid)
+	sig[len(sig)-1] ^= 1
+	valid, _ = pub.Verify([]byte("context"), sig, msg)
+	assert.False(t, valid)
+	_, err = pub.Verify([]byte("context"), sig[1:], msg)
+	assert.Equal(t, ErrSignatureLength, err)
+	_, err = key.Sign(make([]byte, 256), msg, nil)
+	assert.Equal(t, ErrContextTooLong, err)
+
+	data, err := key.Bytes()
+	assert.Nil(t, err)
+	parsed := new(SLHDSAPrivateKey)
+	assert.Nil(t, parsed.FromBytes(data, int(SLH_DSA_SHAKE_128F)))
+	assert.Equal(t, key, parsed)
+	assert.Equal(t, ErrKeyLength, parsed.FromBytes(data, int(SLH_DSA_SHAKE_256F)))
+	assert.Equal(t, ErrUnsupported, parsed.FromBytes(data, 0))
+	_, err = GenerateKey(ParamSet(13), nil)
+	assert.Equal(t, ErrUnsupported, err)
+}
+
+// slow reports whether set is a small "s" parameter set, their signatures take seconds
+func slow(set ParamSet) bool {
+	name := set.String()
+	return name[len(name)-1] == 's'
+}
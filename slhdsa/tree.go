@@ -0,0 +1,272 @@
+package slhdsa
+
+// ctx is a parameter set with the hash functions of a key, skSeed is nil when verifying
+type ctx struct {
+	p      *params
+	h      hasher
+	skSeed []byte
+}
+
+// base2b returns outLen digits of b bits of x, FIPS 205 algorithm 4
+func base2b(x []byte, b uint, outLen int) []uint32 {
+	ret := make([]uint32, outLen)
+	var total uint32
+	var bits uint
+	for i := range ret {
+		for bits < b {
+			total = total<<8 | uint32(x[0])
+			x = x[1:]
+			bits += 8
+		}
+		bits -= b
+		ret[i] = total >> bits & (1<<b - 1)
+		total &= 1<<bits - 1
+	}
+	return ret
+}
+
+// prf computes PRF(PK.seed, SK.seed, ADRS), it has the form of F
+func (c *ctx) prf(a *address) []byte {
+	out := make([]byte, c.p.n)
+	c.h.f(out, a, c.skSeed)
+	return out
+}
+
+// chain applies steps [start, start+steps) of a WOTS+ chain to x in place, FIPS 205 algorithm 5
+func (c *ctx) chain(x []byte, start, steps uint32, a *address) {
+	for j := start; j < start+steps; j++ {
+		a.setHash(j)
+		c.h.f(x, a, x)
+	}
+}
+
+// wotsDigits returns the base-w digits of msg followed by the ones of its checksum
+func (c *ctx) wotsDigits(msg []byte) []uint32 {
+	digits := base2b(msg, lgW, c.p.len1())
+	var csum uint32
+	for _, d := range digits {
+		csum += w - 1 - d
+	}
+	// len2 * lgW = 12 bits, shifted to fill two bytes
+	csum <<= 4
+	return append(digits, csum>>12&0xf, csum>>8&0xf, csum>>4&0xf)
+}
+
+// wotsSK returns the secret value of chain i of the WOTS+ key at a
+func (c *ctx) wotsSK(a *address, i uint32) []byte {
+	sk := *a
+	sk.setTypeAndClear(addrWOTSPRF)
+	sk.setKeyPair(a.keyPair())
+	sk.setChain(i)
+	return c.prf(&sk)
+}
+
+// wotsCompress computes the WOTS+ public key from the ends of the chains
+func (c *ctx) wotsCompress(tmp []byte, a *address) []byte {
+	pk := *a
+	pk.setTypeAndClear(addrWOTSPK)
+	pk.setKeyPair(a.keyPair())
+	out := make([]byte, c.p.n)
+	c.h.h(out, &pk, tmp)
+	return out
+}
+
+// wotsPKGen returns the WOTS+ public key at a, FIPS 205 algorithm 6
+func (c *ctx) wotsPKGen(a *address) []byte {
+	n := c.p.n
+	tmp := make([]byte, 0, c.p.wotsLen()*n)
+	for i := uint32(0); i < uint32(c.p.wotsLen()); i++ {
+		x := c.wotsSK(a, i)
+		a.setChain(i)
+		c.chain(x, 0, w-1, a)
+		tmp = append(tmp, x...)
+	}
+	return c.wotsCompress(tmp, a)
+}
+
+// wotsSign signs the n bytes msg with the WOTS+ key at a, FIPS 205 algorithm 7
+func (c *ctx) wotsSign(msg []byte, a *address) []byte {
+	sig := make([]byte, 0, c.p.wotsLen()*c.p.n)
+	for i, d := range c.wotsDigits(msg) {
+		x := c.wotsSK(a, uint32(i))
+		a.setChain(uint32(i))
+		c.chain(x, 0, d, a)
+		sig = append(sig, x...)
+	}
+	return sig
+}
+
+// wotsPKFromSig computes the WOTS+ public key of sig, FIPS 205 algorithm 8
+func (c *ctx) wotsPKFromSig(sig, msg []byte, a *address) []byte {
+	n := c.p.n
+	tmp := append([]byte(nil), sig...)
+	for i, d := range c.wotsDigits(msg) {
+		a.setChain(uint32(i))
+		c.chain(tmp[i*n:(i+1)*n], d, w-1-d, a)
+	}
+	return c.wotsCompress(tmp, a)
+}
+
+// merkle hashes the leaves level by level and returns the root and the authentication path of
+// leaf idx. The address of the node j of height z is the tree index offset + j, offset is
+// the index of the first node of the level in a larger tree, FIPS 205 algorithms 9 and 14.
+func (c *ctx) merkle(leaves [][]byte, idx uint32, offset uint32, a *address) (root, auth []byte) {
+	n := c.p.n
+	auth = make([]byte, 0, c.height(len(leaves))*n)
+	buf := make([]byte, 2*n)
+	for z := uint32(1); len(leaves) > 1; z++ {
+		auth = append(auth, leaves[idx^1]...)
+		idx >>= 1
+		offset >>= 1
+		a.setTreeHeight(z)
+		next := make([][]byte, len(leaves)/2)
+		for j := range next {
+			copy(buf, leaves[2*j])
+			copy(buf[n:], leaves[2*j+1])
+			a.setTreeIndex(offset + uint32(j))
+			next[j] = make([]byte, n)
+			c.h.h(next[j], a, buf)
+		}
+		leaves = next
+	}
+	return leaves[0], auth
+}
+
+// height returns the height of a tree of count leaves
+func (c *ctx) height(count int) int {
+	z := 0
+	for ; count > 1; count >>= 1 {
+		z++
+	}
+	return z
+}
+
+// rootFromAuth computes the root from the leaf idx and its authentication path,
+// offset as in merkle, FIPS 205 algorithms 11 and 17
+func (c *ctx) rootFromAuth(node []byte, idx uint32, offset uint32, auth []byte, a *address) []byte {
+	n := c.p.n
+	buf := make([]byte, 2*n)
+	for z := 0; z*n < len(auth); z++ {
+		a.setTreeHeight(uint32(z + 1))
+		a.setTreeIndex((offset + idx) >> uint(z+1))
+		if idx>>uint(z)&1 == 0 {
+			copy(buf, node)
+			copy(buf[n:], auth[z*n:(z+1)*n])
+		} else {
+			copy(buf, auth[z*n:(z+1)*n])
+			copy(buf[n:], node)
+		}
+		node = make([]byte, n)
+		c.h.h(node, a, buf)
+	}
+	return node
+}
+
+// xmssSign returns the XMSS signature of msg by leaf idx of the tree at a and the root of
+// the tree, FIPS 205 algorithms 9 and 10
+func (c *ctx) xmssSign(msg []byte, idx uint32, a *address) (sig, root []byte) {
+	leaves := make([][]byte, 1<<uint(c.p.hp))
+	for i := range leaves {
+		a.setTypeAndClear(addrWOTSHash)
+		a.setKeyPair(uint32(i))
+		leaves[i] = c.wotsPKGen(a)
+	}
+	a.setTypeAndClear(addrTree)
+	root, auth := c.merkle(leaves, idx, 0, a)
+	a.setTypeAndClear(addrWOTSHash)
+	a.setKeyPair(idx)
+	return append(c.wotsSign(msg, a), auth...), root
+}
+
+// xmssPKFromSig computes the root of the tree at a from a XMSS signature, FIPS 205 algorithm 11
+func (c *ctx) xmssPKFromSig(idx uint32, sig, msg []byte, a *address) []byte {
+	wotsLen := c.p.wotsLen() * c.p.n
+	a.setTypeAndClear(addrWOTSHash)
+	a.setKeyPair(idx)
+	node := c.wotsPKFromSig(sig[:wotsLen], msg, a)
+	a.setTypeAndClear(addrTree)
+	return c.rootFromAuth(node, idx, 0, sig[wotsLen:], a)
+}
+
+// htSign signs msg with the hypertree leaf idxLeaf of the tree idxTree, FIPS 205 algorithm 12
+func (c *ctx) htSign(msg []byte, idxTree uint64, idxLeaf uint32) []byte {
+	var a address
+	sig := make([]byte, 0, c.p.d*c.p.xmssSigLen())
+	for j := 0; j < c.p.d; j++ {
+		a.setLayer(uint32(j))
+		a.setTree(idxTree)
+		part, root := c.xmssSign(msg, idxLeaf, &a)
+		sig = append(sig, part...)
+		msg = root
+		idxLeaf = uint32(idxTree & (1<<uint(c.p.hp) - 1))
+		idxTree >>= uint(c.p.hp)
+	}
+	return sig
+}
+
+// htRoot computes the root of the hypertree from a signature of msg, FIPS 205 algorithm 13
+func (c *ctx) htRoot(msg, sig []byte, idxTree uint64, idxLeaf uint32) []byte {
+	var a address
+	l := c.p.xmssSigLen()
+	for j := 0; j < c.p.d; j++ {
+		a.setLayer(uint32(j))
+		a.setTree(idxTree)
+		msg = c.xmssPKFromSig(idxLeaf, sig[j*l:(j+1)*l], msg, &a)
+		idxLeaf = uint32(idxTree & (1<<uint(c.p.hp) - 1))
+		idxTree >>= uint(c.p.hp)
+	}
+	return msg
+}
+
+// forsSK returns the FORS secret value idx of the key pair at a, FIPS 205 algorithm 14
+func (c *ctx) forsSK(a *address, idx uint32) []byte {
+	sk := *a
+	sk.setTypeAndClear(addrFORSPRF)
+	sk.setKeyPair(a.keyPair())
+	sk.setTreeIndex(idx)
+	return c.prf(&sk)
+}
+
+// forsLeaf computes the leaf idx from its secret value in place
+func (c *ctx) forsLeaf(sk []byte, idx uint32, a *address) []byte {
+	a.setTreeHeight(0)
+	a.setTreeIndex(idx)
+	c.h.f(sk, a, sk)
+	return sk
+}
+
+// forsSign signs md with the FORS key at a, FIPS 205 algorithms 15 and 16
+func (c *ctx) forsSign(md []byte, a *address) []byte {
+	p := c.p
+	sig := make([]byte, 0, p.forsSigLen())
+	leaves := make([][]byte, 1<<uint(p.a))
+	for i, idx := range base2b(md, uint(p.a), p.k) {
+		offset := uint32(i) << uint(p.a)
+		for j := range leaves {
+			leaves[j] = c.forsLeaf(c.forsSK(a, offset+uint32(j)), offset+uint32(j), a)
+		}
+		_, auth := c.merkle(leaves, idx, offset, a)
+		sig = append(sig, c.forsSK(a, offset+idx)...)
+		sig = append(sig, auth...)
+	}
+	return sig
+}
+
+// forsPKFromSig computes the FORS public key from a signature of md, FIPS 205 algorithm 17
+func (c *ctx) forsPKFromSig(sig, md []byte, a *address) []byte {
+	p := c.p
+	roots := make([]byte, 0, p.k*p.n)
+	l := (p.a + 1) * p.n
+	for i, idx := range base2b(md, uint(p.a), p.k) {
+		part := sig[i*l : (i+1)*l]
+		offset := uint32(i) << uint(p.a)
+		leaf := c.forsLeaf(append([]byte(nil), part[:p.n]...), offset+idx, a)
+		roots = append(roots, c.rootFromAuth(leaf, idx, offset, part[p.n:], a)...)
+	}
+	pk := *a
+	pk.setTypeAndClear(addrFORSRoots)
+	pk.setKeyPair(a.keyPair())
+	out := make([]byte, p.n)
+	c.h.h(out, &pk, roots)
+	return out
+}
@@ -0,0 +1,53 @@
+package smt
+
+import (
+	"fmt"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+// treeHasher hashes leaves and nodes and keeps the roots of the empty
+// subtrees: defaults[Depth] is the empty leaf and defaults[0] the root of
+// an empty tree.
+type treeHasher struct {
+	hasher   *hash.Hasher
+	prefix   [1]byte
+	defaults [Depth + 1][]byte
+}
+
+func newTreeHasher(hashType hash.HashType) (*treeHasher, error) {
+	h := hash.NewHasher(hashType)
+	if h == nil {
+		return nil, fmt.Errorf("smt: unsupported hash type 0x%x", uint32(hashType))
+	}
+	th := &treeHasher{hasher: h}
+	th.defaults[Depth] = make([]byte, h.Size())
+	for i := Depth - 1; i >= 0; i-- {
+		th.defaults[i] = th.hashNode(th.defaults[i+1], th.defaults[i+1])
+	}
+	return th, nil
+}
+
+// hashLeaf returns HASH(0x00 || key || value)
+func (th *treeHasher) hashLeaf(key, value []byte) []byte {
+	th.prefix[0] = leafPrefix
+	r, _ := th.hasher.BatchHash([][]byte{th.prefix[:], key, value})
+	return r
+}
+
+// hashNode returns HASH(0x01 || left || right)
+func (th *treeHasher) hashNode(left, right []byte) []byte {
+	th.prefix[0] = nodePrefix
+	r, _ := th.hasher.BatchHash([][]byte{th.prefix[:], left, right})
+	return r
+}
+
+// bit returns the bit of key at the specific depth, the most significant bit first.
+func bit(key []byte, depth int) byte {
+	return key[depth>>3] >> (7 - uint(depth&7)) & 1
+}
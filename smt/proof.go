@@ -0,0 +1,94 @@
+package smt
+
+import (
+	"bytes"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+//Proof a compact Merkle proof of a key.
+// Bitmap bit i (most significant bit first) is set if the sibling on depth i+1 is not an empty subtree,
+// SideNodes are the non-empty siblings from the top of the tree to the bottom.
+type Proof struct {
+	Bitmap    [KeySize]byte
+	SideNodes [][]byte
+}
+
+//Prove return a proof of the current value of key, it proves membership if key
+// has a value and non-membership otherwise.
+func (t *SparseMerkleTree) Prove(key []byte) (*Proof, error) {
+	if len(key) != KeySize {
+		return nil, ErrKeySize
+	}
+	proof := new(Proof)
+	node := t.root
+	for depth := 0; depth < Depth; depth++ {
+		if bytes.Equal(node, t.th.defaults[depth]) {
+			// all remaining siblings are empty
+			break
+		}
+		left, right, err := t.children(node, depth)
+		if err != nil {
+			return nil, err
+		}
+		sibling := right
+		node = left
+		if bit(key, depth) == 1 {
+			sibling, node = left, right
+		}
+		if !bytes.Equal(sibling, t.th.defaults[depth+1]) {
+			proof.Bitmap[depth>>3] |= 0x80 >> uint(depth&7)
+			proof.SideNodes = append(proof.SideNodes, append([]byte(nil), sibling...))
+		}
+	}
+	return proof, nil
+}
+
+//VerifyProof check proof against root. If value is empty, the proof must show key is absent,
+// otherwise it must show key has the specific value.
+func VerifyProof(hashType hash.HashType, root, key, value []byte, proof *Proof) error {
+	if len(key) != KeySize {
+		return ErrKeySize
+	}
+	if proof == nil {
+		return ErrInvalidProof
+	}
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return err
+	}
+	node := th.defaults[Depth]
+	if len(value) != 0 {
+		node = th.hashLeaf(key, value)
+	}
+	side := len(proof.SideNodes)
+	for depth := Depth - 1; depth >= 0; depth-- {
+		sibling := th.defaults[depth+1]
+		if proof.Bitmap[depth>>3]&(0x80>>uint(depth&7)) != 0 {
+			if side == 0 {
+				return ErrInvalidProof
+			}
+			side--
+			sibling = proof.SideNodes[side]
+			if len(sibling) != len(node) {
+				return ErrInvalidProof
+			}
+		}
+		if bytes.Equal(node, th.defaults[depth+1]) && bytes.Equal(sibling, node) {
+			node = th.defaults[depth]
+			continue
+		}
+		if bit(key, depth) == 0 {
+			node = th.hashNode(node, sibling)
+		} else {
+			node = th.hashNode(sibling, node)
+		}
+	}
+	if side != 0 {
+		return ErrInvalidProof
+	}
+	if !bytes.Equal(node, root) {
+		return ErrRootMismatch
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Package smt implements a sparse Merkle tree with 256-bit keys. It commits
+// to a key-value map and proves that a key is present with a value or that
+// it is absent. Empty subtrees are never stored: their roots are computed
+// once per hash algorithm and collapsed out of proofs.
+package smt
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/meshplus/crypto-standard/hash"
+)
+
+const (
+	//KeySize is the size of keys in bytes, use the digest of a 256-bit hash as key
+	KeySize = 32
+	//Depth is the number of levels below the root
+	Depth = KeySize * 8
+)
+
+//error defines
+var (
+	ErrKeySize        = errors.New("smt: key must be 32 bytes")
+	ErrBatchSize      = errors.New("smt: keys and values differ in length")
+	ErrInvalidProof   = errors.New("smt: invalid proof")
+	ErrRootMismatch   = errors.New("smt: calculated root mismatch")
+	ErrCorruptedStore = errors.New("smt: node is missing from store")
+)
+
+//SparseMerkleTree a sparse Merkle tree over a Store.
+// A key is absent if its value is empty, so setting an empty value deletes the key.
+// SparseMerkleTree is not safe for concurrent use.
+type SparseMerkleTree struct {
+	th    *treeHasher
+	store Store
+	root  []byte
+}
+
+//New return an empty tree, if store is nil a MemoryStore is used
+func New(hashType hash.HashType, store Store) (*SparseMerkleTree, error) {
+	th, err := newTreeHasher(hashType)
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &SparseMerkleTree{th: th, store: store, root: th.defaults[0]}, nil
+}
+
+//Import return a tree with the specific root whose nodes are already in store
+func Import(hashType hash.HashType, store Store, root []byte) (*SparseMerkleTree, error) {
+	t, err := New(hashType, store)
+	if err != nil {
+		return nil, err
+	}
+	if len(root) != len(t.root) {
+		return nil, errors.New("smt: root size mismatch")
+	}
+	t.root = append([]byte(nil), root...)
+	return t, nil
+}
+
+//Root return the root hash of the tree
+func (t *SparseMerkleTree) Root() []byte {
+	return append([]byte(nil), t.root...)
+}
+
+//Get return the value of key, or nil if key is absent
+func (t *SparseMerkleTree) Get(key []byte) ([]byte, error) {
+	if len(key) != KeySize {
+		return nil, ErrKeySize
+	}
+	node := t.root
+	for depth := 0; depth < Depth; depth++ {
+		if bytes.Equal(node, t.th.defaults[depth]) {
+			return nil, nil
+		}
+		left, right, err := t.children(node, depth)
+		if err != nil {
+			return nil, err
+		}
+		if bit(key, depth) == 0 {
+			node = left
+		} else {
+			node = right
+		}
+	}
+	if bytes.Equal(node, t.th.defaults[Depth]) {
+		return nil, nil
+	}
+	v, err := t.store.Get(node)
+	if err != nil {
+		return nil, ErrCorruptedStore
+	}
+	return append([]byte(nil), v...), nil
+}
+
+//Update set the value of key, an empty value deletes key
+func (t *SparseMerkleTree) Update(key, value []byte) error {
+	return t.UpdateBatch([][]byte{key}, [][]byte{value})
+}
+
+//Delete remove key from the tree
+func (t *SparseMerkleTree) Delete(key []byte) error {
+	return t.UpdateBatch([][]byte{key}, [][]byte{nil})
+}
+
+type kv struct {
+	key, value []byte
+}
+
+//UpdateBatch set values[i] to keys[i] for every i, the root is recalculated once and
+// every interior node shared by the updated keys is hashed and stored once.
+// If a key is repeated the last value wins.
+func (t *SparseMerkleTree) UpdateBatch(keys, values [][]byte) error {
+	if len(keys) != len(values) {
+		return ErrBatchSize
+	}
+	kvs := make([]kv, len(keys))
+	for i := range keys {
+		if len(keys[i]) != KeySize {
+			return ErrKeySize
+		}
+		kvs[i] = kv{key: keys[i], value: values[i]}
+	}
+	sort.SliceStable(kvs, func(i, j int) bool {
+		return bytes.Compare(kvs[i].key, kvs[j].key) < 0
+	})
+	// keep the last value of repeated keys
+	uniq := kvs[:0]
+	for i := range kvs {
+		if len(uniq) > 0 && bytes.Equal(uniq[len(uniq)-1].key, kvs[i].key) {
+			uniq[len(uniq)-1] = kvs[i]
+			continue
+		}
+		uniq = append(uniq, kvs[i])
+	}
+	root, err := t.update(t.root, 0, uniq)
+	if err != nil {
+		return err
+	}
+	t.root = root
+	return nil
+}
+
+// update applies sorted kvs to the subtree rooted at node on the specific depth
+// and returns the new subtree root.
+func (t *SparseMerkleTree) update(node []byte, depth int, kvs []kv) ([]byte, error) {
+	if len(kvs) == 0 {
+		return node, nil
+	}
+	if depth == Depth {
+		// all keys are equal here and repeated keys have been removed
+		if len(kvs[0].value) == 0 {
+			return t.th.defaults[Depth], nil
+		}
+		leaf := t.th.hashLeaf(kvs[0].key, kvs[0].value)
+		if err := t.store.Set(leaf, kvs[0].value); err != nil {
+			return nil, err
+		}
+		return leaf, nil
+	}
+
+	left, right, err := t.children(node, depth)
+	if err != nil {
+		return nil, err
+	}
+	i := sort.Search(len(kvs), func(i int) bool { return bit(kvs[i].key, depth) == 1 })
+	if left, err = t.update(left, depth+1, kvs[:i]); err != nil {
+		return nil, err
+	}
+	if right, err = t.update(right, depth+1, kvs[i:]); err != nil {
+		return nil, err
+	}
+
+	def := t.th.defaults[depth+1]
+	if bytes.Equal(left, def) && bytes.Equal(right, def) {
+		return t.th.defaults[depth], nil
+	}
+	h := t.th.hashNode(left, right)
+	value := make([]byte, 0, len(left)+len(right))
+	value = append(append(value, left...), right...)
+	if err := t.store.Set(h, value); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// children returns the children of node on the specific depth
+func (t *SparseMerkleTree) children(node []byte, depth int) (left, right []byte, err error) {
+	if bytes.Equal(node, t.th.defaults[depth]) {
+		return t.th.defaults[depth+1], t.th.defaults[depth+1], nil
+	}
+	v, err := t.store.Get(node)
+	if err != nil || len(v) != 2*len(node) {
+		return nil, nil, ErrCorruptedStore
+	}
+	return v[:len(node)], v[len(node):], nil
+}
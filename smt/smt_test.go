@@ -0,0 +1,135 @@
+package smt
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/meshplus/crypto-standard/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func testKey(i int) []byte {
+	k, _ := hash.NewHasher(hash.KECCAK_256).Hash([]byte(fmt.Sprintf("key%d", i)))
+	return k
+}
+
+func TestEmptyTree(t *testing.T) {
+	tree, err := New(hash.KECCAK_256, nil)
+	assert.Nil(t, err)
+	v, err := tree.Get(testKey(0))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+
+	proof, err := tree.Prove(testKey(0))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(proof.SideNodes))
+	assert.Nil(t, VerifyProof(hash.KECCAK_256, tree.Root(), testKey(0), nil, proof))
+	assert.NotNil(t, VerifyProof(hash.KECCAK_256, tree.Root(), testKey(0), []byte("v"), proof))
+
+	_, err = tree.Get([]byte("short"))
+	assert.Equal(t, ErrKeySize, err)
+	_, err = New(hash.HashType(0xff), nil)
+	assert.NotNil(t, err)
+}
+
+func TestUpdateAndProve(t *testing.T) {
+	for _, typ := range []hash.HashType{hash.KECCAK_256, hash.SHA3_256} {
+		tree, err := New(typ, nil)
+		assert.Nil(t, err)
+		emptyRoot := tree.Root()
+		for i := 0; i < 20; i++ {
+			assert.Nil(t, tree.Update(testKey(i), []byte(fmt.Sprintf("value%d", i))))
+		}
+		for i := 0; i < 25; i++ {
+			v, err := tree.Get(testKey(i))
+			assert.Nil(t, err)
+			proof, err := tree.Prove(testKey(i))
+			assert.Nil(t, err)
+			if i < 20 {
+				assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), v)
+				assert.Nil(t, VerifyProof(typ, tree.Root(), testKey(i), v, proof))
+				assert.NotNil(t, VerifyProof(typ, tree.Root(), testKey(i), []byte("forged"), proof))
+				assert.NotNil(t, VerifyProof(typ, tree.Root(), testKey(i), nil, proof))
+			} else {
+				assert.Nil(t, v)
+				assert.Nil(t, VerifyProof(typ, tree.Root(), testKey(i), nil, proof))
+				assert.NotNil(t, VerifyProof(typ, tree.Root(), testKey(i), []byte("forged"), proof))
+			}
+			// proofs are compact, only the few non-empty siblings are kept
+			assert.True(t, len(proof.SideNodes) < 16)
+		}
+
+		// removing every key gives the empty tree back
+		for i := 0; i < 20; i++ {
+			assert.Nil(t, tree.Delete(testKey(i)))
+		}
+		assert.Equal(t, emptyRoot, tree.Root())
+	}
+}
+
+func TestUpdateBatch(t *testing.T) {
+	single, err := New(hash.KECCAK_256, nil)
+	assert.Nil(t, err)
+	batch, err := New(hash.KECCAK_256, nil)
+	assert.Nil(t, err)
+
+	var keys, values [][]byte
+	for i := 0; i < 50; i++ {
+		keys = append(keys, testKey(i))
+		values = append(values, []byte{byte(i)})
+		assert.Nil(t, single.Update(testKey(i), []byte{byte(i)}))
+	}
+	// repeated key, the last one wins
+	keys = append(keys, testKey(3))
+	values = append(values, []byte("last"))
+	assert.Nil(t, single.Update(testKey(3), []byte("last")))
+
+	assert.Nil(t, batch.UpdateBatch(keys, values))
+	assert.Equal(t, single.Root(), batch.Root())
+	v, err := batch.Get(testKey(3))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("last"), v)
+
+	assert.Equal(t, ErrBatchSize, batch.UpdateBatch(keys, values[1:]))
+}
+
+func TestImport(t *testing.T) {
+	store := NewMemoryStore()
+	tree, err := New(hash.KECCAK_256, store)
+	assert.Nil(t, err)
+	assert.Nil(t, tree.Update(testKey(1), []byte("one")))
+	oldRoot := tree.Root()
+	assert.Nil(t, tree.Update(testKey(1), []byte("uno")))
+	assert.True(t, store.Len() > 0)
+
+	// the old version is still readable from the store
+	old, err := Import(hash.KECCAK_256, store, oldRoot)
+	assert.Nil(t, err)
+	v, err := old.Get(testKey(1))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("one"), v)
+
+	// a root unknown to the store
+	bad, err := Import(hash.KECCAK_256, NewMemoryStore(), oldRoot)
+	assert.Nil(t, err)
+	_, err = bad.Get(testKey(1))
+	assert.Equal(t, ErrCorruptedStore, err)
+}
+
+func TestProofTamper(t *testing.T) {
+	tree, err := New(hash.KECCAK_256, nil)
+	assert.Nil(t, err)
+	for i := 0; i < 8; i++ {
+		assert.Nil(t, tree.Update(testKey(i), []byte("v")))
+	}
+	proof, err := tree.Prove(testKey(0))
+	assert.Nil(t, err)
+	assert.True(t, len(proof.SideNodes) > 0)
+
+	short := &Proof{Bitmap: proof.Bitmap, SideNodes: proof.SideNodes[1:]}
+	assert.Equal(t, ErrInvalidProof, VerifyProof(hash.KECCAK_256, tree.Root(), testKey(0), []byte("v"), short))
+	long := &Proof{Bitmap: proof.Bitmap, SideNodes: append([][]byte{bytes.Repeat([]byte{1}, 32)}, proof.SideNodes...)}
+	assert.Equal(t, ErrInvalidProof, VerifyProof(hash.KECCAK_256, tree.Root(), testKey(0), []byte("v"), long))
+	assert.Equal(t, ErrInvalidProof, VerifyProof(hash.KECCAK_256, tree.Root(), testKey(0), []byte("v"), nil))
+}
@@ -0,0 +1,68 @@
+package smt
+
+import (
+	"errors"
+	"sync"
+)
+
+//ErrKeyNotFound is returned by Store.Get when the key is absent
+var ErrKeyNotFound = errors.New("smt: key not found")
+
+//Store is the storage backend of SparseMerkleTree.
+// Keys are node hashes, values are the concatenated children of interior nodes or the values of leaves.
+// Nodes are never deleted by the tree, so an old root stays readable until the caller prunes it.
+type Store interface {
+	//Get return the value of key or ErrKeyNotFound
+	Get(key []byte) ([]byte, error)
+	//Set put key and value, the store must not keep a reference to value after Set returns
+	Set(key, value []byte) error
+	//Delete remove key, it is not an error to delete an absent key
+	Delete(key []byte) error
+}
+
+//MemoryStore a Store kept in a map, it is safe for concurrent use
+type MemoryStore struct {
+	lock sync.RWMutex
+	m    map[string][]byte
+}
+
+//NewMemoryStore return an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{m: make(map[string][]byte)}
+}
+
+//Get return the value of key or ErrKeyNotFound
+func (s *MemoryStore) Get(key []byte) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	v, ok := s.m[string(key)]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return v, nil
+}
+
+//Set put key and value
+func (s *MemoryStore) Set(key, value []byte) error {
+	v := make([]byte, len(value))
+	copy(v, value)
+	s.lock.Lock()
+	s.m[string(key)] = v
+	s.lock.Unlock()
+	return nil
+}
+
+//Delete remove key
+func (s *MemoryStore) Delete(key []byte) error {
+	s.lock.Lock()
+	delete(s.m, string(key))
+	s.lock.Unlock()
+	return nil
+}
+
+//Len return the number of stored nodes
+func (s *MemoryStore) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return len(s.m)
+}